@@ -8,6 +8,7 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
+	"github.com/opendatahub-io/odh-cli/cmd/backup/verify"
 	backuppkg "github.com/opendatahub-io/odh-cli/pkg/backup"
 )
 
@@ -17,14 +18,26 @@ const (
 )
 
 const cmdLong = `
-Backs up OpenShift AI workloads (notebooks, etc.) and their dependencies
-(ConfigMaps, Secrets, PVCs) to a directory structure.
+Backs up OpenShift AI workloads (notebooks, pipelines, model serving, Ray,
+training jobs, LlamaStack, guardrails) and their dependencies (ConfigMaps,
+Secrets, PVCs, ServiceAccounts, ServingRuntimes) to a directory structure.
 
 The backup command:
-  - Discovers workload resources based on --includes/--exclude filters
+  - Discovers workload resources based on --includes/--exclude filters, or
+    every CRD labeled platform.opendatahub.io/part-of with --all-odh-workloads
+  - Resolves workload types to the version the cluster serves; types may be
+    given as resource[.version][.group] or Kind[.group]
   - For each workload, identifies and backs up referenced dependencies
   - Strips cluster-specific metadata for portability
   - Organizes backups by namespace: $output-dir/$namespace/$GVR-$name.yaml
+  - Records the source cluster, settings and a SHA-256 of every file in
+    $output-dir/manifest.json
+
+Secrets are written according to --secrets:
+  plain    as they are, with base64-encoded values (default)
+  redact   as stubs that keep the key names only; restore skips them
+  encrypt  encrypted with age to $GVR-$name.yaml.age, for the --recipient
+           public keys or a key derived from --passphrase-file
 
 Examples:
   # Backup all notebooks to /tmp/backup
@@ -37,11 +50,18 @@ Examples:
   odh-cli backup --output-dir /backup \
     --includes notebooks.kubeflow.org \
     --includes inferenceservices.serving.kserve.io \
-    --exclude datasciencepipelinesapplications.opendatahub.io
+    --exclude DataSciencePipelinesApplication
+
+  # Backup every ODH workload type installed on the cluster
+  odh-cli backup --output-dir /backup --all-odh-workloads
 
   # Strip additional fields
   odh-cli backup --output-dir /backup \
     --strip ".spec.customField"
+
+  # Backup to a compressed archive and verify it
+  odh-cli backup --archive backup.tar.gz
+  odh-cli backup verify backup.tar.gz
 `
 
 const cmdExample = `
@@ -54,8 +74,23 @@ const cmdExample = `
   # Backup with verbose output
   odh-cli backup --output-dir /backup -v
 
+  # Backup every ODH workload type installed on the cluster
+  odh-cli backup --output-dir /backup --all-odh-workloads
+
   # Strip additional fields
   odh-cli backup --output-dir /backup --strip ".spec.customField"
+
+  # Backup to a compressed archive
+  odh-cli backup --archive backup.tar.gz
+
+  # Verify a backup against its manifest
+  odh-cli backup verify backup.tar.gz
+
+  # Encrypt Secrets with an age public key
+  odh-cli backup --output-dir /backup --secrets encrypt --recipient age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p
+
+  # Keep only the key names of Secrets
+  odh-cli backup --output-dir /backup --secrets redact
 `
 
 // AddCommand adds the backup command to the root command.
@@ -89,5 +124,6 @@ func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
 	}
 
 	command.AddFlags(cmd.Flags())
+	verify.AddCommand(cmd, streams)
 	root.AddCommand(cmd)
 }
@@ -0,0 +1,63 @@
+package verify
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	backuppkg "github.com/opendatahub-io/odh-cli/pkg/backup"
+)
+
+const (
+	cmdName  = "verify <dir|archive>"
+	cmdShort = "Verify a backup against its manifest"
+)
+
+const cmdLong = `
+Checks the integrity of a backup directory or archive against the manifest.json
+written by backup. Every file listed in the manifest must be present with the
+recorded SHA-256, no other files may be present, and every dependency edge must
+point to a file of the backup.
+
+Exits with an error when any problem is found.
+`
+
+const cmdExample = `
+  # Verify a backup directory
+  odh-cli backup verify /backup
+
+  # Verify an archive and print the source cluster recorded in the manifest
+  odh-cli backup verify backup.tar.gz -v
+`
+
+// AddCommand adds the verify subcommand to the backup command.
+func AddCommand(parent *cobra.Command, streams genericiooptions.IOStreams) {
+	command := backuppkg.NewVerifyCommand(streams)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			command.Path = args[0]
+
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,74 @@
+package diff
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	lintpkg "github.com/opendatahub-io/odh-cli/pkg/lint"
+)
+
+const (
+	cmdName  = "diff OLD NEW"
+	cmdShort = "Compare two saved lint reports"
+)
+
+const cmdLong = `
+Compares two reports written by 'lint -o json' (or '-o yaml') and shows what changed
+between the runs, without connecting to a cluster.
+
+Checks are matched by group, kind and name. For each check that differs, the diff reports:
+  - newly failing or newly passing checks
+  - changes in impact level (prohibited, critical, warning)
+  - impacted objects that were added (+) or resolved (-)
+  - checks that were added or removed between the runs
+
+Unchanged checks are omitted.
+`
+
+const cmdExample = `
+  # Save a weekly report
+  kubectl odh lint --target-version 3.0 -o json > lint-week2.json
+
+  # Compare with last week's report
+  kubectl odh lint diff lint-week1.json lint-week2.json
+
+  # Output the differences as JSON
+  kubectl odh lint diff lint-week1.json lint-week2.json -o json
+`
+
+// AddCommand adds the diff subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	streams genericiooptions.IOStreams,
+) {
+	command := lintpkg.NewDiffCommand(streams)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		Args:          cobra.ExactArgs(2), //nolint:mnd // OLD and NEW reports
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			command.OldReport = args[0]
+			command.NewReport = args[1]
+
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
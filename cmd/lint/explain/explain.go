@@ -0,0 +1,67 @@
+package explain
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	lintpkg "github.com/opendatahub-io/odh-cli/pkg/lint"
+)
+
+const (
+	cmdName  = "explain <check-id>"
+	cmdShort = "Show the documentation of a lint check"
+)
+
+const cmdLong = `
+Shows the documentation of a lint check: its name, group, kind and type, the full
+description, the remediation, and the condition reasons the check can report together
+with their impact levels (prohibited, blocking, advisory or none).
+
+Run 'kubectl odh lint list' to see the IDs of the available checks.
+`
+
+const cmdExample = `
+  # Explain the ServiceMesh removal check
+  kubectl odh lint explain components.kserve.servicemesh-removal
+
+  # Explain a check as YAML
+  kubectl odh lint explain workloads.notebook.impacted-workloads -o yaml
+`
+
+// AddCommand adds the explain subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := lintpkg.NewExplainCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			command.CheckID = args[0]
+
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,76 @@
+package fix
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	lintpkg "github.com/opendatahub-io/odh-cli/pkg/lint"
+)
+
+const (
+	cmdName  = "fix"
+	cmdShort = "Generate or apply remediation patches for fixable lint findings"
+)
+
+const cmdLong = `
+Runs the lint checks and collects remediation patches from checks whose fix is
+mechanical, for example:
+  - removing '.spec.apiServer.managedPipelines.instructLab' from DSPAs
+  - setting opendatahub.io/managed=false and extending serviceAnnotationDisallowedList
+    in the inferenceservice-config ConfigMap
+  - adding missing kueue.x-k8s.io/queue-name labels to workloads in namespaces with
+    a single LocalQueue
+
+By default the patches are printed as a shell script of 'kubectl patch' commands that
+can be reviewed and run. With --apply, the patches are listed and applied to the
+cluster after confirmation.
+
+Findings whose fix needs a decision are not patched; follow their remediation in the
+lint report.
+`
+
+const cmdExample = `
+  # Print the patches for an upgrade to 3.0 as a reviewable script
+  kubectl odh lint fix --target-version 3.0 > fixes.sh
+
+  # Apply the patches after confirmation
+  kubectl odh lint fix --target-version 3.0 --apply
+
+  # Fix only Kueue findings of the current installation
+  kubectl odh lint fix --checks "workloads.kueue.*" --apply
+`
+
+// AddCommand adds the fix subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := lintpkg.NewFixCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
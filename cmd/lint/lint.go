@@ -8,6 +8,12 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
+	"github.com/opendatahub-io/odh-cli/cmd/lint/diff"
+	"github.com/opendatahub-io/odh-cli/cmd/lint/explain"
+	"github.com/opendatahub-io/odh-cli/cmd/lint/fix"
+	"github.com/opendatahub-io/odh-cli/cmd/lint/list"
+	"github.com/opendatahub-io/odh-cli/cmd/lint/serve"
+	"github.com/opendatahub-io/odh-cli/cmd/lint/snapshot"
 	lintpkg "github.com/opendatahub-io/odh-cli/pkg/lint"
 )
 
@@ -20,7 +26,9 @@ const cmdLong = `
 Validates the current OpenShift AI installation or assesses upgrade readiness.
 
 LINT MODE (without --target-version):
-  Validates the current cluster state and reports configuration issues.
+  Validates the current installation and reports health and configuration issues.
+  Every check applicable to the installed version is run, e.g. DSC/DSCI readiness,
+  Kueue data integrity and notebook integrity.
 
 UPGRADE MODE (with --target-version):
   Assesses upgrade readiness by comparing current version against target version.
@@ -48,6 +56,9 @@ Examples:
 
   # Validate only component checks
   kubectl odh lint --checks "components"
+
+  # Assess upgrade readiness offline from a must-gather or backup directory
+  kubectl odh lint --target-version 3.0 --from-snapshot ./must-gather
 `
 const cmdExample = `
   # Validate current cluster state
@@ -59,11 +70,62 @@ const cmdExample = `
   # Output results in JSON format
   kubectl odh lint -o json
 
+  # Produce a JUnit XML report for CI
+  kubectl odh lint --target-version 3.1 -o junit > lint-report.xml
+
+  # Write a self-contained HTML report for stakeholders
+  kubectl odh lint --target-version 3.1 --report-file upgrade-report.html
+
+  # Fail the pipeline on blocking findings too (exit code 4 blocking, 5 prohibited)
+  kubectl odh lint --target-version 3.1 --fail-on blocking
+
+  # Publish the results to a ConfigMap in the applications namespace for the console
+  kubectl odh lint --target-version 3.1 --publish
+
+  # Accept known findings listed in a waivers file until they expire
+  kubectl odh lint --target-version 3.1 --waivers waivers.yaml
+
+  # Give slow checks more time; checks exceeding their deadline are reported as failed
+  kubectl odh lint --target-version 3.1 --check-timeout 1m --check-timeout workloads.kserve.impacted-workloads=5m
+
+  # Assess only the workloads of one tenant's namespaces
+  kubectl odh lint --target-version 3.1 --namespace-selector tenant=acme
+
+  # Assess several clusters at once, one per kubeconfig context
+  kubectl odh lint --target-version 3.1 --contexts prod-east,prod-west
+
+  # Re-run the checks every hour and expose Prometheus metrics on :8080/metrics
+  kubectl odh lint serve --target-version 3.1
+
+  # Compare two saved JSON reports
+  kubectl odh lint diff last-week.json this-week.json
+
+  # Print remediation patches for fixable findings as a kubectl script
+  kubectl odh lint fix --target-version 3.1 > fixes.sh
+
+  # Also run site-specific checks defined in YAML
+  kubectl odh lint --target-version 3.1 --check-dir ./policy-checks
+
+  # Run only the checks of odh-lint-check-workloads.acme.* plugins on PATH
+  kubectl odh lint --target-version 3.1 --checks "workloads.acme.*"
+
+  # List the available checks and whether they apply to an upgrade to 3.1
+  kubectl odh lint list --target-version 3.1
+
+  # Show the description, remediation and possible findings of a check
+  kubectl odh lint explain components.kserve.servicemesh-removal
+
   # Run only dashboard-related checks
   kubectl odh lint --checks "*dashboard*"
 
   # Check upgrade readiness to version 3.1
   kubectl odh lint --target-version 3.1
+
+  # Assess upgrade readiness offline from a captured cluster snapshot
+  kubectl odh lint --target-version 3.1 --from-snapshot ./must-gather
+
+  # Capture only the objects the checks read, for offline reproduction
+  kubectl odh lint snapshot --target-version 3.1 --output-dir ./lint-snapshot.tar.gz
 `
 
 // AddCommand adds the lint command to the root command.
@@ -120,5 +182,12 @@ func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
 	// Register flags using AddFlags method
 	command.AddFlags(cmd.Flags())
 
+	snapshot.AddCommand(cmd, flags, streams)
+	diff.AddCommand(cmd, streams)
+	fix.AddCommand(cmd, flags, streams)
+	list.AddCommand(cmd, flags, streams)
+	explain.AddCommand(cmd, flags, streams)
+	serve.AddCommand(cmd, flags, streams)
+
 	root.AddCommand(cmd)
 }
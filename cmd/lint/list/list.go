@@ -0,0 +1,72 @@
+package list
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	lintpkg "github.com/opendatahub-io/odh-cli/pkg/lint"
+)
+
+const (
+	cmdName  = "list"
+	cmdShort = "List the available lint checks"
+)
+
+const cmdLong = `
+Lists every registered lint check with its ID, group, kind, type and name. This
+includes the built-in checks, the declarative checks of --check-dir and the check
+plugins found on PATH.
+
+When a cluster is reachable, the APPLIES column shows whether each check would run
+against the installed version, or against the upgrade to --target-version. Without a
+cluster the checks are listed without applicability.
+
+Use 'kubectl odh lint explain <check-id>' to show the documentation of a check.
+`
+
+const cmdExample = `
+  # List all checks
+  kubectl odh lint list
+
+  # List the workload checks that apply to an upgrade to 3.0
+  kubectl odh lint list --checks "workloads" --target-version 3.0
+
+  # List the checks as JSON
+  kubectl odh lint list -o json
+`
+
+// AddCommand adds the list subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := lintpkg.NewListCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
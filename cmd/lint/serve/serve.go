@@ -0,0 +1,73 @@
+package serve
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	lintpkg "github.com/opendatahub-io/odh-cli/pkg/lint"
+)
+
+const (
+	cmdName  = "serve"
+	cmdShort = "Continuously monitor upgrade readiness and expose Prometheus metrics"
+)
+
+const cmdLong = `
+Runs the lint checks on an interval until interrupted, and serves the outcome of
+the latest run over HTTP:
+
+  /metrics  Prometheus metrics: findings per check and impact, impacted objects
+            per check and namespace, check durations and the detected versions
+  /report   the latest results as a JSON DiagnosticResultList
+  /healthz  liveness probe
+
+Meant to run as a pod in the weeks before an upgrade, so that regressions such as
+a newly created Serverless InferenceService can be alerted on. A failed run is
+counted in odh_lint_runs_total{result="error"} and the previous results are kept.
+`
+
+const cmdExample = `
+  # Monitor readiness for an upgrade to 3.3 and re-run the checks every 30 minutes
+  kubectl odh lint serve --target-version 3.3 --interval 30m
+
+  # Also publish every run to the odh-upgrade-readiness ConfigMap
+  kubectl odh lint serve --target-version 3.3 --publish --listen-address :9090
+
+  # Alert on prohibited findings (PromQL)
+  #   sum(odh_lint_check_findings{impact="prohibited"}) > 0
+`
+
+// AddCommand adds the serve subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := lintpkg.NewServeCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
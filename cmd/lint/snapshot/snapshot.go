@@ -0,0 +1,71 @@
+package snapshot
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	lintpkg "github.com/opendatahub-io/odh-cli/pkg/lint"
+)
+
+const (
+	cmdName  = "snapshot"
+	cmdShort = "Capture the cluster objects read by lint checks"
+)
+
+const cmdLong = `
+Runs the lint checks for a target version and captures every object they read,
+including OLM Subscriptions and ClusterServiceVersions, into a snapshot.
+
+The snapshot contains exactly what is needed to reproduce the lint report offline
+with 'lint --from-snapshot', and is much smaller than a full must-gather.
+Output is reproducible: files are written in the backup layout
+($namespace/$resource.$group-$name.yaml) and archives carry no timestamps.
+
+Secret values are blanked before writing; keys are kept.
+`
+
+const cmdExample = `
+  # Capture a snapshot directory for an upgrade to 3.0
+  kubectl odh lint snapshot --target-version 3.0 --output-dir ./lint-snapshot
+
+  # Capture a single archive to share with support
+  kubectl odh lint snapshot --target-version 3.0 --output-dir ./lint-snapshot.tar.gz
+
+  # Reproduce the lint report from the archive
+  kubectl odh lint --target-version 3.0 --from-snapshot ./lint-snapshot.tar.gz
+`
+
+// AddCommand adds the snapshot subcommand to the lint command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := lintpkg.NewSnapshotCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -8,7 +9,9 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	"github.com/opendatahub-io/odh-cli/cmd/lint"
+	"github.com/opendatahub-io/odh-cli/cmd/upgrade"
 	"github.com/opendatahub-io/odh-cli/cmd/version"
+	pkgcmd "github.com/opendatahub-io/odh-cli/pkg/cmd"
 )
 
 func main() {
@@ -27,11 +30,20 @@ func main() {
 
 	version.AddCommand(cmd, flags)
 	lint.AddCommand(cmd, flags)
+	upgrade.AddCommand(cmd, flags)
 
 	if err := cmd.Execute(); err != nil {
+		// Errors such as the lint verdict carry their own exit code.
+		exitCode := 1
+
+		var exitCoder pkgcmd.ExitCoder
+		if errors.As(err, &exitCoder) {
+			exitCode = exitCoder.ExitCode()
+		}
+
 		if _, writeErr := os.Stderr.WriteString(err.Error() + "\n"); writeErr != nil {
-			os.Exit(1)
+			os.Exit(exitCode)
 		}
-		os.Exit(1)
+		os.Exit(exitCode)
 	}
 }
@@ -0,0 +1,93 @@
+package restore
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	backuppkg "github.com/opendatahub-io/odh-cli/pkg/backup"
+)
+
+const (
+	cmdName  = "restore"
+	cmdShort = "Restore OpenShift AI workloads and dependencies from a backup"
+)
+
+const cmdLong = `
+Restores a backup directory or archive written by 'backup --output-dir' or
+'backup --archive' into the cluster.
+
+The restore command:
+  - Reads the backup layout $from/$namespace/$GVR-$name.yaml
+  - Applies Secrets, ConfigMaps, PVCs, ServiceAccounts and ServingRuntimes first,
+    then the workloads that use them
+  - Uses server-side apply, with --dry-run validating objects without persisting them
+  - Optionally restores namespaces under another name with --namespace-map
+  - Decrypts Secrets written with 'backup --secrets=encrypt' using --identity
+    or --passphrase-file, and skips Secrets written with --secrets=redact
+  - Prints the outcome of every object (created, updated, skipped, conflict, failed)
+
+Objects that already exist are handled according to --on-conflict:
+  skip       leave the existing object untouched (default)
+  overwrite  apply the backed up object, taking ownership of conflicting fields
+  fail       restore nothing if any object already exists
+
+The command exits with a non-zero code when any object could not be restored.
+`
+
+const cmdExample = `
+  # Validate a restore with server-side dry run
+  odh-cli restore --from /tmp/backup --dry-run
+
+  # Restore, overwriting objects that already exist
+  odh-cli restore --from /tmp/backup --on-conflict overwrite
+
+  # Restore the objects of namespace team-a into team-a-restored
+  odh-cli restore --from /tmp/backup --namespace-map team-a=team-a-restored
+
+  # Restore from a backup archive
+  odh-cli restore --from /tmp/backup.tar.gz
+
+  # Print the per-object summary as JSON
+  odh-cli restore --from /tmp/backup -o json
+
+  # Restore a backup whose Secrets are encrypted with age
+  odh-cli restore --from /tmp/backup --identity ~/.config/age/key.txt
+`
+
+// AddCommand adds the restore command to the root command.
+func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
+	streams := genericiooptions.IOStreams{
+		In:     root.InOrStdin(),
+		Out:    root.OutOrStdout(),
+		ErrOut: root.ErrOrStderr(),
+	}
+
+	command := backuppkg.NewRestoreCommand(streams)
+	command.ConfigFlags = flags
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := command.Complete(); err != nil {
+				return fmt.Errorf("completing command: %w", err)
+			}
+			if err := command.Validate(); err != nil {
+				return fmt.Errorf("validating command: %w", err)
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	root.AddCommand(cmd)
+}
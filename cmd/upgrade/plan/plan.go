@@ -0,0 +1,76 @@
+package plan
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	lintpkg "github.com/opendatahub-io/odh-cli/pkg/lint"
+)
+
+const (
+	cmdName  = "plan"
+	cmdShort = "Plan a multi-hop upgrade and assess every hop"
+)
+
+const cmdLong = `
+Computes the sequence of supported upgrades from the installed OpenShift AI version
+to the target version, using the upgrade graph bundled with the CLI, for example
+2.16 → 2.22 → 2.25 → 3.3. Each release of the graph also records the minimum
+OpenShift version it requires.
+
+The lint checks are run for every hop with the hop's versions as current and target
+version, and the findings are reported per hop: a hop with prohibited or blocking
+findings cannot be performed until they are resolved or waived with --waivers. All
+hops are assessed against the current cluster state.
+
+The exit code follows --fail-on across all hops, as for 'lint'.
+`
+
+const cmdExample = `
+  # Plan the upgrade to 3.3
+  kubectl odh upgrade plan --target-version 3.3
+
+  # Show only blocking findings per hop
+  kubectl odh upgrade plan --target-version 3.3 --severity critical
+
+  # Accept known findings listed in a waivers file until they expire
+  kubectl odh upgrade plan --target-version 3.3 --waivers waivers.yaml
+
+  # Output the plan as JSON
+  kubectl odh upgrade plan --target-version 3.3 -o json
+`
+
+// AddCommand adds the plan subcommand to the upgrade command.
+func AddCommand(
+	parent *cobra.Command,
+	flags *genericclioptions.ConfigFlags,
+	streams genericiooptions.IOStreams,
+) {
+	command := lintpkg.NewPlanCommand(streams, flags)
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		Example:       cmdExample,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			//nolint:wrapcheck // Errors from Complete and Validate are already contextualized
+			if err := command.Complete(); err != nil {
+				return err
+			}
+			//nolint:wrapcheck // Errors from Validate are already contextualized
+			if err := command.Validate(); err != nil {
+				return err
+			}
+
+			return command.Run(cmd.Context())
+		},
+	}
+
+	command.AddFlags(cmd.Flags())
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,43 @@
+package upgrade
+
+import (
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/cmd/upgrade/plan"
+)
+
+const (
+	cmdName  = "upgrade"
+	cmdShort = "Plan OpenShift AI upgrades"
+)
+
+const cmdLong = `
+The upgrade command helps plan OpenShift AI upgrades.
+
+Available subcommands:
+  plan  Compute the supported upgrade path to a target version and assess each hop
+`
+
+// AddCommand adds the upgrade command to the root command.
+func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
+	streams := genericiooptions.IOStreams{
+		In:     root.InOrStdin(),
+		Out:    root.OutOrStdout(),
+		ErrOut: root.ErrOrStderr(),
+	}
+
+	cmd := &cobra.Command{
+		Use:           cmdName,
+		Short:         cmdShort,
+		Long:          cmdLong,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	plan.AddCommand(cmd, flags, streams)
+
+	root.AddCommand(cmd)
+}
@@ -0,0 +1,183 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// IsArchive reports whether path names a tar archive by its extension.
+func IsArchive(path string) bool {
+	return strings.HasSuffix(path, ".tar") || isGzipArchive(path)
+}
+
+func isGzipArchive(path string) bool {
+	return strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
+
+// writeArchive packs the manifest and the files it lists from dir into a tar archive
+// at dest, gzip-compressed for .tar.gz and .tgz. Entries carry no timestamps or
+// ownership, so the same backup always produces the same archive.
+func writeArchive(dir string, dest string, manifest *Manifest) error {
+	if err := os.MkdirAll(filepath.Dir(dest), dirPermissions); err != nil {
+		return fmt.Errorf("creating directory: %w", err)
+	}
+
+	f, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, filePermissions)
+	if err != nil {
+		return fmt.Errorf("creating archive: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	var w io.Writer = f
+
+	var gz *gzip.Writer
+	if isGzipArchive(dest) {
+		gz = gzip.NewWriter(f)
+		w = gz
+	}
+
+	tw := tar.NewWriter(w)
+
+	names := make([]string, 0, len(manifest.Files)+1)
+	names = append(names, ManifestFileName)
+
+	for _, file := range manifest.Files {
+		names = append(names, file.Path)
+	}
+
+	for _, name := range names {
+		if err := addArchiveEntry(tw, dir, name); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("closing archive: %w", err)
+	}
+
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			return fmt.Errorf("closing gzip stream: %w", err)
+		}
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing archive: %w", err)
+	}
+
+	return nil
+}
+
+// addArchiveEntry adds the file name, relative to dir, to the archive.
+func addArchiveEntry(tw *tar.Writer, dir string, name string) error {
+	data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", name, err)
+	}
+
+	hdr := &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     filePermissions,
+		Size:     int64(len(data)),
+		ModTime:  time.Unix(0, 0),
+	}
+
+	if err := tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("writing archive header: %w", err)
+	}
+
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("writing archive entry: %w", err)
+	}
+
+	return nil
+}
+
+// walkBackup calls fn with the slash-separated path, relative to the backup root, of
+// every regular file of a backup directory or archive.
+func walkBackup(path string, fn func(name string, rd io.Reader) error) error {
+	if IsArchive(path) {
+		return walkArchive(path, fn)
+	}
+
+	return walkDir(path, fn)
+}
+
+func walkDir(dir string, fn func(name string, rd io.Reader) error) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return fmt.Errorf("resolving path: %w", err)
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("opening file: %w", err)
+		}
+
+		defer func() { _ = f.Close() }()
+
+		return fn(filepath.ToSlash(rel), f)
+	})
+}
+
+func walkArchive(path string, fn func(name string, rd io.Reader) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening archive: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	var rd io.Reader = f
+
+	if isGzipArchive(path) {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("reading gzip stream: %w", err)
+		}
+
+		defer func() { _ = gz.Close() }()
+
+		rd = gz
+	}
+
+	tr := tar.NewReader(rd)
+
+	for {
+		hdr, err := tr.Next()
+
+		switch {
+		case errors.Is(err, io.EOF):
+			return nil
+		case err != nil:
+			return fmt.Errorf("reading archive: %w", err)
+		}
+
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		if err := fn(strings.TrimPrefix(hdr.Name, "./"), tr); err != nil {
+			return err
+		}
+	}
+}
@@ -2,23 +2,34 @@ package backup
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 
+	"filippo.io/age"
 	"github.com/spf13/pflag"
 	"golang.org/x/sync/errgroup"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
 	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies"
 	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies/dspa"
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies/guardrails"
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies/inferenceservice"
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies/llamastack"
 	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies/notebooks"
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies/pytorchjob"
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies/ray"
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies/servingruntime"
 	"github.com/opendatahub-io/odh-cli/pkg/backup/pipeline"
 	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/discovery"
 )
 
 const (
@@ -33,6 +44,7 @@ type Command struct {
 	*SharedOptions
 
 	OutputDir    string
+	Archive      string
 	StripFields  []string
 	Includes     []string
 	Excludes     []string
@@ -40,7 +52,22 @@ type Command struct {
 	Dependencies bool
 	DryRun       bool
 
+	// AllODHWorkloads backs up every workload type whose CRD carries the ODH
+	// platform label, instead of Includes.
+	AllODHWorkloads bool
+
+	// Secrets selects how Secret objects are written: plain, redact or encrypt.
+	Secrets SecretPolicy
+
+	// Recipients are the age public keys Secrets are encrypted to with --secrets=encrypt.
+	Recipients []string
+
+	// PassphraseFile holds the passphrase Secrets are encrypted with, instead of Recipients.
+	PassphraseFile string
+
 	depRegistry *dependencies.Registry
+	manifest    *manifestRecorder
+	recipients  []age.Recipient
 }
 
 // NewCommand creates a new backup Command.
@@ -48,15 +75,20 @@ func NewCommand(streams genericiooptions.IOStreams) *Command {
 	return &Command{
 		SharedOptions: NewSharedOptions(streams),
 		Dependencies:  true,
+		Secrets:       SecretsPlain,
 	}
 }
 
 // AddFlags adds flags to the command.
 func (c *Command) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.OutputDir, "output-dir", "", "Output directory for backups (if not specified, dumps to stdout)")
+	fs.StringVar(&c.Archive, "archive", "", "Also pack the backup and its manifest into a tar archive (.tar, .tar.gz or .tgz)")
 	fs.StringArrayVar(&c.StripFields, "strip", nil, "Field paths to strip (repeatable, e.g., --strip .status)")
-	fs.StringArrayVar(&c.Includes, "includes", nil, "Workload types to include (repeatable, e.g., --includes notebooks.kubeflow.org)")
+	fs.StringArrayVar(&c.Includes, "includes", nil,
+		"Workload types to include as resource[.version][.group] or Kind[.group] (repeatable, e.g., --includes notebooks.kubeflow.org)")
 	fs.StringArrayVar(&c.Excludes, "exclude", nil, "Workload types to exclude (repeatable)")
+	fs.BoolVar(&c.AllODHWorkloads, "all-odh-workloads", false,
+		"Back up every workload type whose CRD is labeled platform.opendatahub.io/part-of, instead of --includes")
 	fs.IntVar(&c.MaxWorkers, "max-workers", 0, "Maximum concurrent workers (0 = auto-detect based on CPU count)")
 	fs.BoolVarP(&c.Verbose, "verbose", "v", false, "Enable verbose output")
 	fs.BoolVar(&c.DryRun, "dry-run", false, "Preview backup without writing files (automatically enables verbose)")
@@ -68,6 +100,11 @@ func (c *Command) AddFlags(fs *pflag.FlagSet) {
 
 	// Dependency resolution
 	fs.BoolVar(&c.Dependencies, "dependencies", true, "Resolve and backup workload dependencies (ConfigMaps, PVCs, Secrets)")
+
+	// Secret handling
+	fs.StringVar((*string)(&c.Secrets), "secrets", string(SecretsPlain), "How to write Secrets (plain|redact|encrypt)")
+	fs.StringArrayVar(&c.Recipients, "recipient", nil, "age public key to encrypt Secrets to with --secrets=encrypt (repeatable)")
+	fs.StringVar(&c.PassphraseFile, "passphrase-file", "", "File holding the passphrase to encrypt Secrets with, instead of --recipient")
 }
 
 // Complete populates derived values and performs setup.
@@ -81,7 +118,7 @@ func (c *Command) Complete() error {
 		c.Verbose = true
 	}
 
-	if len(c.Includes) == 0 {
+	if len(c.Includes) == 0 && !c.AllODHWorkloads {
 		c.Includes = DefaultWorkloadTypes
 	}
 
@@ -96,6 +133,15 @@ func (c *Command) Complete() error {
 		}
 	}
 
+	if c.Secrets == SecretsEncrypt {
+		recipients, err := ParseRecipients(c.Recipients, c.PassphraseFile)
+		if err != nil {
+			return err
+		}
+
+		c.recipients = recipients
+	}
+
 	// Create registry - always needed even if empty
 	c.depRegistry = dependencies.NewRegistry()
 
@@ -103,6 +149,12 @@ func (c *Command) Complete() error {
 	if c.Dependencies {
 		c.depRegistry.MustRegister(notebooks.NewResolver())
 		c.depRegistry.MustRegister(dspa.NewResolver())
+		c.depRegistry.MustRegister(inferenceservice.NewResolver())
+		c.depRegistry.MustRegister(servingruntime.NewResolver())
+		c.depRegistry.MustRegister(ray.NewResolver())
+		c.depRegistry.MustRegister(pytorchjob.NewResolver())
+		c.depRegistry.MustRegister(llamastack.NewResolver())
+		c.depRegistry.MustRegister(guardrails.NewResolver())
 	}
 
 	return nil
@@ -114,6 +166,38 @@ func (c *Command) Validate() error {
 		return err
 	}
 
+	if c.AllODHWorkloads && len(c.Includes) > 0 {
+		return errors.New("--all-odh-workloads and --includes are mutually exclusive")
+	}
+
+	if c.Archive != "" && !IsArchive(c.Archive) {
+		return fmt.Errorf("invalid --archive %s: must end in .tar, .tar.gz or .tgz", c.Archive)
+	}
+
+	return c.validateSecrets()
+}
+
+// validateSecrets checks the Secret policy and its encryption options.
+func (c *Command) validateSecrets() error {
+	if !slices.Contains([]SecretPolicy{SecretsPlain, SecretsRedact, SecretsEncrypt}, c.Secrets) {
+		return fmt.Errorf("invalid --secrets: %s (must be one of: plain, redact, encrypt)", c.Secrets)
+	}
+
+	keys := len(c.Recipients) > 0 || c.PassphraseFile != ""
+
+	switch {
+	case c.Secrets != SecretsEncrypt && keys:
+		return errors.New("--recipient and --passphrase-file require --secrets=encrypt")
+	case c.Secrets != SecretsEncrypt:
+		return nil
+	case !keys:
+		return errors.New("--secrets=encrypt requires --recipient or --passphrase-file")
+	case len(c.Recipients) > 0 && c.PassphraseFile != "":
+		return errors.New("--recipient and --passphrase-file are mutually exclusive")
+	case c.OutputDir == "" && c.Archive == "":
+		return errors.New("--secrets=encrypt requires --output-dir or --archive")
+	}
+
 	return nil
 }
 
@@ -122,13 +206,30 @@ func (c *Command) Run(ctx context.Context) error {
 	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
 	defer cancel()
 
+	// An archive without --output-dir is staged in a temporary directory.
+	if c.Archive != "" && c.OutputDir == "" && !c.DryRun {
+		staging, err := os.MkdirTemp("", "odh-backup-")
+		if err != nil {
+			return fmt.Errorf("creating staging directory: %w", err)
+		}
+
+		defer func() { _ = os.RemoveAll(staging) }()
+
+		c.OutputDir = staging
+	}
+
 	if c.OutputDir != "" {
 		if err := os.MkdirAll(c.OutputDir, dirPermissions); err != nil {
 			return fmt.Errorf("creating output directory: %w", err)
 		}
+
+		c.manifest = newManifestRecorder(c.OutputDir, c.filePath)
 	}
 
-	gvrsToBackup := c.resolveWorkloadGVRs()
+	gvrsToBackup, err := c.resolveWorkloadGVRs(ctx)
+	if err != nil {
+		return err
+	}
 
 	if c.Verbose {
 		mode := "with dependencies"
@@ -164,6 +265,11 @@ func (c *Command) Run(ctx context.Context) error {
 		IO:            c.IO,
 		DryRun:        c.DryRun,
 		OutputDir:     c.OutputDir,
+		FileSuffix:    c.fileSuffix,
+	}
+
+	if c.manifest != nil && !c.DryRun {
+		writer.Written = c.manifest.recordDependencies
 	}
 
 	// Process each workload type
@@ -173,14 +279,42 @@ func (c *Command) Run(ctx context.Context) error {
 		}
 	}
 
-	if c.DryRun {
+	switch {
+	case c.DryRun:
 		c.IO.Errorf("Dry-run complete (no files written)")
-	} else if c.OutputDir == "" && c.Verbose {
-		c.IO.Errorf("Backup complete (stdout)")
-	} else {
+	case c.OutputDir == "":
+		if c.Verbose {
+			c.IO.Errorf("Backup complete (stdout)")
+		}
+	default:
+		if err := c.finish(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// finish writes the manifest of a backup directory and packs the archive, if requested.
+func (c *Command) finish(ctx context.Context) error {
+	manifest := c.buildManifest(ctx, c.manifest.manifestFiles())
+
+	if err := WriteManifest(c.OutputDir, manifest); err != nil {
+		return err
+	}
+
+	if c.Archive == "" {
 		c.IO.Errorf("Backup complete: %s", c.OutputDir)
+
+		return nil
+	}
+
+	if err := writeArchive(c.OutputDir, c.Archive, manifest); err != nil {
+		return fmt.Errorf("writing archive %s: %w", c.Archive, err)
 	}
 
+	c.IO.Errorf("Backup complete: %s", c.Archive)
+
 	return nil
 }
 
@@ -225,29 +359,59 @@ func (c *Command) runPipeline(
 	return nil
 }
 
-// resolveWorkloadGVRs converts include/exclude strings to GVRs.
-func (c *Command) resolveWorkloadGVRs() []schema.GroupVersionResource {
-	//nolint:prealloc // Size unknown until references are extracted
+// resolveWorkloadGVRs resolves the include and exclude strings to the preferred
+// served version of each workload type. With AllODHWorkloads, the workload types are
+// discovered from the ODH CRD label instead. Included types the cluster does not
+// serve are skipped with a warning, so the default types work on every cluster.
+func (c *Command) resolveWorkloadGVRs(ctx context.Context) ([]schema.GroupVersionResource, error) {
 	var includeGVRs []schema.GroupVersionResource
+
+	if c.AllODHWorkloads {
+		gvrs, err := discovery.DiscoverWorkloads(ctx, c.Client)
+		if err != nil {
+			return nil, err
+		}
+
+		includeGVRs = gvrs
+	}
+
 	for _, include := range c.Includes {
-		gvr := parseGVRString(include)
+		gvr, err := discovery.ResolveResource(c.Client.RESTMapper(), include)
+		if meta.IsNoMatchError(err) {
+			c.IO.Errorf("Skipping %s: not served by the cluster", include)
+
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid --includes: %w", err)
+		}
+
 		includeGVRs = append(includeGVRs, gvr)
 	}
 
-	excludeSet := make(map[string]bool)
+	excludeSet := make(map[schema.GroupResource]bool)
 	for _, exclude := range c.Excludes {
-		gvr := parseGVRString(exclude)
-		excludeSet[gvr.GroupResource().String()] = true
+		gvr, err := discovery.ResolveResource(c.Client.RESTMapper(), exclude)
+		if meta.IsNoMatchError(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid --exclude: %w", err)
+		}
+
+		excludeSet[gvr.GroupResource()] = true
 	}
 
 	var result []schema.GroupVersionResource
 	for _, gvr := range includeGVRs {
-		if !excludeSet[gvr.GroupResource().String()] {
+		if !excludeSet[gvr.GroupResource()] && !slices.Contains(result, gvr) {
 			result = append(result, gvr)
 		}
 	}
 
-	return result
+	return result, nil
 }
 
 // writeResource strips fields and writes a resource to the output directory or stdout.
@@ -260,6 +424,12 @@ func (c *Command) writeResource(
 		return fmt.Errorf("stripping fields: %w", err)
 	}
 
+	if c.Secrets == SecretsRedact && isSecret(gvr) {
+		if stripped, err = RedactSecret(stripped); err != nil {
+			return err
+		}
+	}
+
 	// Dry-run mode: Log what would be written without actually writing
 	if c.DryRun {
 		return c.logDryRunResource(gvr, stripped)
@@ -269,7 +439,21 @@ func (c *Command) writeResource(
 		return WriteResourceToStdout(c.IO.Out(), gvr, stripped)
 	}
 
-	return WriteResourceToFile(c.OutputDir, gvr, stripped)
+	if c.encrypts(gvr) {
+		err = WriteEncryptedResourceToFile(c.OutputDir, gvr, stripped, c.recipients)
+	} else {
+		err = WriteResourceToFile(c.OutputDir, gvr, stripped)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if c.manifest != nil {
+		return c.manifest.recordFile(gvr, stripped)
+	}
+
+	return nil
 }
 
 // logDryRunResource logs the file path that would be created in dry-run mode.
@@ -289,19 +473,29 @@ func (c *Command) logDryRunResource(
 	}
 
 	// File mode: Show file path that would be created
-	if namespace == "" {
-		namespace = "cluster-scoped"
-	}
-
-	gvrStr := gvr.Resource
-	if gvr.Group != "" {
-		gvrStr = gvr.Resource + "." + gvr.Group
-	}
-
-	filename := fmt.Sprintf("%s-%s.yaml", gvrStr, name)
-	filePath := filepath.Join(c.OutputDir, namespace, filename)
+	filePath := filepath.Join(c.OutputDir, filepath.FromSlash(c.filePath(gvr, obj)))
 
 	c.IO.Errorf("Would create: %s", filePath)
 
 	return nil
 }
+
+// encrypts reports whether resources of type gvr are written encrypted.
+func (c *Command) encrypts(gvr schema.GroupVersionResource) bool {
+	return c.Secrets == SecretsEncrypt && isSecret(gvr)
+}
+
+// fileSuffix returns the suffix appended to the file names of resources of type gvr.
+func (c *Command) fileSuffix(gvr schema.GroupVersionResource) string {
+	if c.encrypts(gvr) {
+		return encryptedSuffix
+	}
+
+	return ""
+}
+
+// filePath returns the slash-separated location of a resource relative to the output
+// directory, including the suffix of encrypted files.
+func (c *Command) filePath(gvr schema.GroupVersionResource, obj *unstructured.Unstructured) string {
+	return resourcePath(gvr, obj) + c.fileSuffix(gvr)
+}
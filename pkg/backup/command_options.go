@@ -22,6 +22,9 @@ type SharedOptions struct {
 	Timeout     time.Duration
 	Client      client.Client
 
+	// APIServer is the address of the cluster API server, set by Complete.
+	APIServer string
+
 	// Throttling settings for Kubernetes API client
 	QPS   float32
 	Burst int
@@ -53,6 +56,7 @@ func (o *SharedOptions) Complete() error {
 	}
 
 	o.Client = c
+	o.APIServer = restConfig.Host
 
 	return nil
 }
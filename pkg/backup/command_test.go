@@ -7,10 +7,17 @@ import (
 	"path/filepath"
 	"testing"
 
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
 	. "github.com/onsi/gomega"
 )
 
@@ -253,3 +260,88 @@ func TestNormalModeStillWorks(t *testing.T) {
 	_, err = os.Stat(expectedFile)
 	g.Expect(err).ToNot(HaveOccurred(), "Normal mode should create files")
 }
+
+// newWorkloadTypesClient returns a client whose REST mapper serves Notebooks and Secrets,
+// and whose Notebook CRD carries the ODH workload label.
+func newWorkloadTypesClient() client.Client {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	for _, rt := range []resources.ResourceType{resources.Notebook, resources.Secret} {
+		mapper.Add(rt.GVK(), meta.RESTScopeNamespace)
+	}
+
+	notebookCRD := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   resources.Notebook.CRDFQN(),
+			Labels: map[string]string{"platform.opendatahub.io/part-of": "workbenches"},
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group:    resources.Notebook.Group,
+			Names:    apiextensionsv1.CustomResourceDefinitionNames{Plural: resources.Notebook.Resource, Kind: resources.Notebook.Kind},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{Name: "v1", Served: true, Storage: true}},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{
+			Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{
+				{Type: apiextensionsv1.Established, Status: apiextensionsv1.ConditionTrue},
+			},
+		},
+	}
+
+	return client.NewForTesting(client.TestClientConfig{
+		APIExtensions: apiextensionsfake.NewClientset(notebookCRD),
+		RESTMapper:    mapper,
+	})
+}
+
+func TestResolveWorkloadGVRs(t *testing.T) {
+	for _, tc := range []struct {
+		name            string
+		includes        []string
+		excludes        []string
+		allODHWorkloads bool
+		expected        []schema.GroupVersionResource
+		warning         string
+	}{
+		{name: "resource.group", includes: []string{"notebooks.kubeflow.org"}, expected: []schema.GroupVersionResource{resources.Notebook.GVR()}},
+		{name: "resource.version.group", includes: []string{"notebooks.v1.kubeflow.org"}, expected: []schema.GroupVersionResource{resources.Notebook.GVR()}},
+		{name: "Kind", includes: []string{"Notebook"}, expected: []schema.GroupVersionResource{resources.Notebook.GVR()}},
+		{name: "Kind.group", includes: []string{"Notebook.kubeflow.org"}, expected: []schema.GroupVersionResource{resources.Notebook.GVR()}},
+		{name: "duplicates", includes: []string{"Notebook", "notebooks"}, expected: []schema.GroupVersionResource{resources.Notebook.GVR()}},
+		{
+			name:     "unserved types are skipped",
+			includes: []string{"notebooks.kubeflow.org", "inferenceservices.serving.kserve.io"},
+			expected: []schema.GroupVersionResource{resources.Notebook.GVR()},
+			warning:  "Skipping inferenceservices.serving.kserve.io: not served by the cluster",
+		},
+		{name: "all ODH workloads", allODHWorkloads: true, expected: []schema.GroupVersionResource{resources.Notebook.GVR()}},
+		{name: "excludes", allODHWorkloads: true, excludes: []string{"Notebook", "rayclusters.ray.io"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var errOut bytes.Buffer
+			cmd := NewCommand(genericiooptions.IOStreams{Out: &bytes.Buffer{}, ErrOut: &errOut})
+			cmd.Client = newWorkloadTypesClient()
+			cmd.Includes = tc.includes
+			cmd.Excludes = tc.excludes
+			cmd.AllODHWorkloads = tc.allODHWorkloads
+
+			gvrs, err := cmd.resolveWorkloadGVRs(t.Context())
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(gvrs).To(Equal(tc.expected))
+			g.Expect(errOut.String()).To(ContainSubstring(tc.warning))
+		})
+	}
+}
+
+func TestValidateAllODHWorkloads(t *testing.T) {
+	g := NewWithT(t)
+
+	cmd := NewCommand(genericiooptions.NewTestIOStreamsDiscard())
+	cmd.AllODHWorkloads = true
+	g.Expect(cmd.Complete()).To(Succeed())
+	g.Expect(cmd.Includes).To(BeEmpty())
+	g.Expect(cmd.Validate()).To(Succeed())
+
+	cmd.Includes = []string{"notebooks.kubeflow.org"}
+	g.Expect(cmd.Validate()).To(MatchError(ContainSubstring("mutually exclusive")))
+}
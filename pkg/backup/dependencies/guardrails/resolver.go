@@ -0,0 +1,59 @@
+package guardrails
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+const (
+	pathOrchestratorConfig = ".spec.orchestratorConfig"
+	pathGatewayConfig      = ".spec.guardrailsGatewayConfig"
+)
+
+// Resolver resolves dependencies for TrustyAI GuardrailsOrchestrators.
+type Resolver struct{}
+
+// NewResolver creates a new GuardrailsOrchestrator dependency resolver.
+func NewResolver() *Resolver {
+	return &Resolver{}
+}
+
+// CanHandle returns true for GuardrailsOrchestrator resources.
+func (r *Resolver) CanHandle(gvr schema.GroupVersionResource) bool {
+	return gvr.Group == resources.GuardrailsOrchestrator.Group &&
+		gvr.Resource == resources.GuardrailsOrchestrator.Resource
+}
+
+// Resolve finds the orchestrator and gateway ConfigMaps of a GuardrailsOrchestrator.
+func (r *Resolver) Resolve(
+	ctx context.Context,
+	c client.Reader,
+	obj *unstructured.Unstructured,
+) ([]dependencies.Dependency, error) {
+	deps, err := dependencies.ResolveByName(ctx, c, obj.GetNamespace(), resources.ConfigMap,
+		r.queryStringField(obj, pathOrchestratorConfig),
+		r.queryStringField(obj, pathGatewayConfig),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("resolving ConfigMaps: %w", err)
+	}
+
+	return deps, nil
+}
+
+func (r *Resolver) queryStringField(obj *unstructured.Unstructured, path string) string {
+	value, err := jq.Query[string](obj, path)
+	if err != nil {
+		return ""
+	}
+
+	return value
+}
@@ -0,0 +1,117 @@
+package guardrails_test
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega/types"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies/guardrails"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.ConfigMap.GVR():             "ConfigMapList",
+	resources.Secret.GVR():                "SecretList",
+	resources.PersistentVolumeClaim.GVR(): "PersistentVolumeClaimList",
+	resources.ServiceAccount.GVR():        "ServiceAccountList",
+}
+
+func TestResolverCanHandle(t *testing.T) {
+	g := NewWithT(t)
+
+	resolver := guardrails.NewResolver()
+
+	g.Expect(resolver.CanHandle(resources.GuardrailsOrchestrator.GVR())).To(BeTrue())
+	g.Expect(resolver.CanHandle(resources.ConfigMap.GVR())).To(BeFalse())
+}
+
+func TestResolverWithOrchestratorAndGatewayConfig(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	orchestrator := createObject(resources.GuardrailsOrchestrator, "guardrails", "default")
+	orchestrator.Object["spec"] = map[string]any{
+		"replicas":                int64(1),
+		"orchestratorConfig":      "fms-orchestr8-config",
+		"guardrailsGatewayConfig": "guardrails-gateway-config",
+	}
+
+	fakeClient := createFakeClient(t,
+		orchestrator,
+		createObject(resources.ConfigMap, "fms-orchestr8-config", "default"),
+		createObject(resources.ConfigMap, "guardrails-gateway-config", "default"),
+	)
+
+	deps, err := guardrails.NewResolver().Resolve(ctx, fakeClient, orchestrator)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(deps).To(ConsistOf(
+		resolved(resources.ConfigMap, "fms-orchestr8-config"),
+		resolved(resources.ConfigMap, "guardrails-gateway-config"),
+	))
+}
+
+func TestResolverWithoutGatewayConfig(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	orchestrator := createObject(resources.GuardrailsOrchestrator, "guardrails", "default")
+	orchestrator.Object["spec"] = map[string]any{
+		"orchestratorConfig": "fms-orchestr8-config",
+	}
+
+	fakeClient := createFakeClient(t, orchestrator)
+
+	deps, err := guardrails.NewResolver().Resolve(ctx, fakeClient, orchestrator)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(deps).To(ConsistOf(And(
+		HaveField("GVR", resources.ConfigMap.GVR()),
+		HaveField("Name", "fms-orchestr8-config"),
+		HaveField("Error", HaveOccurred()),
+	)))
+}
+
+func resolved(resourceType resources.ResourceType, name string) types.GomegaMatcher {
+	return And(
+		HaveField("GVR", resourceType.GVR()),
+		HaveField("Name", name),
+		HaveField("Error", BeNil()),
+	)
+}
+
+func createObject(
+	resourceType resources.ResourceType,
+	name string,
+	namespace string,
+) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(resourceType.GVK())
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+
+	return obj
+}
+
+func createFakeClient(
+	t *testing.T,
+	objs ...runtime.Object,
+) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, objs...)
+
+	return client.NewForTesting(client.TestClientConfig{
+		Dynamic: dynamicClient,
+	})
+}
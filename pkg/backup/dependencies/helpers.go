@@ -2,13 +2,22 @@ package dependencies
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
 	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
 )
 
+// defaultServiceAccount is created in every namespace and is never a dependency.
+const defaultServiceAccount = "default"
+
 // ResolveConfigMaps collects ConfigMap references from various sources,
 // fetches them from the cluster, and returns them as dependencies.
 //
@@ -137,3 +146,155 @@ func ResolvePVCs(
 
 	return deps, nil
 }
+
+// ResolveByName fetches resources of the given type by name and returns them as
+// dependencies. Resources that cannot be fetched are returned with their error, so
+// they are reported like unresolved Secret references. Empty and duplicate names
+// are ignored.
+func ResolveByName(
+	ctx context.Context,
+	c client.Reader,
+	namespace string,
+	resourceType resources.ResourceType,
+	names ...string,
+) ([]Dependency, error) {
+	unique := sets.New[string]()
+	for _, name := range names {
+		if name != "" {
+			unique.Insert(name)
+		}
+	}
+
+	items, fetchErrors, err := kube.FetchResourcesByNameWithErrors(ctx, c, namespace, resourceType, sets.List(unique))
+	if err != nil {
+		return nil, fmt.Errorf("fetching %s: %w", resourceType.Resource, err)
+	}
+
+	deps := make([]Dependency, 0, unique.Len())
+
+	for _, res := range items {
+		deps = append(deps, Dependency{
+			GVR:      resourceType.GVR(),
+			Resource: res,
+			Name:     res.GetName(),
+			Error:    nil,
+		})
+	}
+
+	for name, fetchErr := range fetchErrors {
+		deps = append(deps, Dependency{
+			GVR:      resourceType.GVR(),
+			Resource: nil,
+			Name:     name,
+			Error:    fetchErr,
+		})
+	}
+
+	return deps, nil
+}
+
+// ResolveServiceAccounts fetches the named ServiceAccounts and returns them as
+// dependencies. The "default" ServiceAccount exists in every namespace and is skipped.
+func ResolveServiceAccounts(
+	ctx context.Context,
+	c client.Reader,
+	namespace string,
+	names ...string,
+) ([]Dependency, error) {
+	filtered := make([]string, 0, len(names))
+	for _, name := range names {
+		if name != defaultServiceAccount {
+			filtered = append(filtered, name)
+		}
+	}
+
+	return ResolveByName(ctx, c, namespace, resources.ServiceAccount, filtered...)
+}
+
+// QueryPodSpecs returns the pod specs selected by expr, which must yield an array,
+// e.g. "[.spec.template.spec]". Null entries are dropped, so optional paths can be
+// listed directly.
+func QueryPodSpecs(obj *unstructured.Unstructured, expr string) ([]corev1.PodSpec, error) {
+	specs, err := jq.Query[[]corev1.PodSpec](obj, expr+" | map(select(. != null))")
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return nil, fmt.Errorf("querying pod specs: %w", err)
+	}
+
+	return specs, nil
+}
+
+// ResolvePodSpecs resolves the ConfigMaps, Secrets, PVCs and ServiceAccounts referenced
+// by the containers, init containers, volumes and service accounts of pod specs.
+func ResolvePodSpecs(
+	ctx context.Context,
+	c client.Reader,
+	namespace string,
+	specs ...corev1.PodSpec,
+) ([]Dependency, error) {
+	var sources []any
+	var volumeSources []any
+	var serviceAccounts []string
+
+	for _, spec := range specs {
+		for _, container := range spec.InitContainers {
+			sources = append(sources, container)
+		}
+		for _, container := range spec.Containers {
+			sources = append(sources, container)
+		}
+		for _, volume := range spec.Volumes {
+			sources = append(sources, volume)
+			volumeSources = append(volumeSources, volume)
+		}
+		if spec.ServiceAccountName != "" {
+			serviceAccounts = append(serviceAccounts, spec.ServiceAccountName)
+		}
+	}
+
+	var allDeps []Dependency
+
+	configMapDeps, err := ResolveConfigMaps(ctx, c, namespace, sources...)
+	if err != nil {
+		return nil, fmt.Errorf("resolving ConfigMaps: %w", err)
+	}
+	allDeps = append(allDeps, configMapDeps...)
+
+	secretDeps, err := ResolveSecrets(ctx, c, namespace, sources...)
+	if err != nil {
+		return nil, fmt.Errorf("resolving Secrets: %w", err)
+	}
+	allDeps = append(allDeps, secretDeps...)
+
+	pvcDeps, err := ResolvePVCs(ctx, c, namespace, volumeSources...)
+	if err != nil {
+		return nil, fmt.Errorf("resolving PVCs: %w", err)
+	}
+	allDeps = append(allDeps, pvcDeps...)
+
+	serviceAccountDeps, err := ResolveServiceAccounts(ctx, c, namespace, serviceAccounts...)
+	if err != nil {
+		return nil, fmt.Errorf("resolving ServiceAccounts: %w", err)
+	}
+	allDeps = append(allDeps, serviceAccountDeps...)
+
+	return allDeps, nil
+}
+
+// Unique drops dependencies that refer to the same resource as an earlier one, keeping
+// the first occurrence.
+func Unique(deps []Dependency) []Dependency {
+	seen := sets.New[string]()
+	result := make([]Dependency, 0, len(deps))
+
+	for _, dep := range deps {
+		key := dep.GVR.String() + "/" + dep.Name
+		if seen.Has(key) {
+			continue
+		}
+
+		seen.Insert(key)
+		result = append(result, dep)
+	}
+
+	return result
+}
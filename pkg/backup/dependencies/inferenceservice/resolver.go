@@ -0,0 +1,163 @@
+package inferenceservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+const (
+	// storageConfigSecret is the Secret KServe reads data connection credentials from
+	// when a model sets .storage.key.
+	storageConfigSecret = "storage-config"
+	pvcURIScheme        = "pvc://"
+	pathPodSpecs        = "[.spec.predictor, .spec.transformer, .spec.explainer]"
+	pathModel           = ".spec.predictor.model"
+	pathRuntime         = ".spec.predictor.model.runtime"
+	pathStorageKey      = ".spec.predictor.model.storage.key"
+	pathStorageURIs     = "[.spec.predictor | .. | objects | .storageUri | strings]"
+	pathSASecrets       = "[.secrets[]?.name]"
+)
+
+// Resolver resolves dependencies for KServe InferenceServices.
+type Resolver struct{}
+
+// NewResolver creates a new InferenceService dependency resolver.
+func NewResolver() *Resolver {
+	return &Resolver{}
+}
+
+// CanHandle returns true for KServe InferenceService resources.
+func (r *Resolver) CanHandle(gvr schema.GroupVersionResource) bool {
+	return gvr.Group == resources.InferenceService.Group && gvr.Resource == resources.InferenceService.Resource
+}
+
+// Resolve finds all dependencies for an InferenceService: its ServingRuntime, the
+// storage Secrets and PVC of the model, the ServiceAccounts of its components with
+// their credential Secrets, and everything referenced by component containers and volumes.
+func (r *Resolver) Resolve(
+	ctx context.Context,
+	c client.Reader,
+	obj *unstructured.Unstructured,
+) ([]dependencies.Dependency, error) {
+	namespace := obj.GetNamespace()
+
+	specs, err := dependencies.QueryPodSpecs(obj, pathPodSpecs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The model spec is a container, so its env and envFrom may reference ConfigMaps
+	// and Secrets as well.
+	model, err := jq.Query[corev1.Container](obj, pathModel)
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return nil, fmt.Errorf("querying model: %w", err)
+	}
+	if err == nil {
+		specs = append(specs, corev1.PodSpec{Containers: []corev1.Container{model}})
+	}
+
+	allDeps, err := dependencies.ResolvePodSpecs(ctx, c, namespace, specs...)
+	if err != nil {
+		return nil, err
+	}
+
+	runtimeDeps, err := dependencies.ResolveByName(ctx, c, namespace, resources.ServingRuntime,
+		r.queryStringField(obj, pathRuntime))
+	if err != nil {
+		return nil, fmt.Errorf("resolving ServingRuntime: %w", err)
+	}
+	allDeps = append(allDeps, runtimeDeps...)
+
+	var secretNames []string
+
+	// Data connections are merged into the storage-config Secret under their own
+	// name, so both are needed to restore the model's storage access.
+	if key := r.queryStringField(obj, pathStorageKey); key != "" {
+		secretNames = append(secretNames, key, storageConfigSecret)
+	}
+
+	// KServe also reads S3 credentials from Secrets attached to the ServiceAccount.
+	for _, dep := range allDeps {
+		if dep.GVR == resources.ServiceAccount.GVR() && dep.Resource != nil {
+			secretNames = append(secretNames, r.serviceAccountSecrets(dep.Resource)...)
+		}
+	}
+
+	secretDeps, err := dependencies.ResolveByName(ctx, c, namespace, resources.Secret, secretNames...)
+	if err != nil {
+		return nil, fmt.Errorf("resolving storage Secrets: %w", err)
+	}
+	allDeps = append(allDeps, secretDeps...)
+
+	pvcDeps, err := dependencies.ResolveByName(ctx, c, namespace, resources.PersistentVolumeClaim,
+		r.storagePVCs(obj)...)
+	if err != nil {
+		return nil, fmt.Errorf("resolving storage PVCs: %w", err)
+	}
+	allDeps = append(allDeps, pvcDeps...)
+
+	return dependencies.Unique(allDeps), nil
+}
+
+// storagePVCs returns the PVCs named by pvc://<claim>/<path> storage URIs.
+func (r *Resolver) storagePVCs(obj *unstructured.Unstructured) []string {
+	uris, err := jq.Query[[]string](obj, pathStorageURIs)
+	if err != nil {
+		return nil
+	}
+
+	var names []string
+
+	for _, uri := range uris {
+		claim, found := strings.CutPrefix(uri, pvcURIScheme)
+		if !found {
+			continue
+		}
+
+		claim, _, _ = strings.Cut(claim, "/")
+		names = append(names, claim)
+	}
+
+	return names
+}
+
+// serviceAccountSecrets returns the Secrets attached to a ServiceAccount, skipping the
+// token and pull Secrets that are generated for every ServiceAccount.
+func (r *Resolver) serviceAccountSecrets(sa *unstructured.Unstructured) []string {
+	names, err := jq.Query[[]string](sa, pathSASecrets)
+	if err != nil {
+		return nil
+	}
+
+	var result []string
+
+	for _, name := range names {
+		if strings.Contains(name, "-token-") || strings.Contains(name, "-dockercfg-") {
+			continue
+		}
+
+		result = append(result, name)
+	}
+
+	return result
+}
+
+func (r *Resolver) queryStringField(obj *unstructured.Unstructured, path string) string {
+	value, err := jq.Query[string](obj, path)
+	if err != nil {
+		return ""
+	}
+
+	return value
+}
@@ -0,0 +1,178 @@
+package inferenceservice_test
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega/types"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies/inferenceservice"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.ConfigMap.GVR():             "ConfigMapList",
+	resources.Secret.GVR():                "SecretList",
+	resources.PersistentVolumeClaim.GVR(): "PersistentVolumeClaimList",
+	resources.ServiceAccount.GVR():        "ServiceAccountList",
+	resources.ServingRuntime.GVR():        "ServingRuntimeList",
+}
+
+func TestResolverCanHandle(t *testing.T) {
+	g := NewWithT(t)
+
+	resolver := inferenceservice.NewResolver()
+
+	g.Expect(resolver.CanHandle(resources.InferenceService.GVR())).To(BeTrue())
+	g.Expect(resolver.CanHandle(resources.ServingRuntime.GVR())).To(BeFalse())
+}
+
+func TestResolverWithRuntimeStorageAndServiceAccount(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	isvc := createObject(resources.InferenceService, "model", "default")
+	isvc.Object["spec"] = map[string]any{
+		"predictor": map[string]any{
+			"serviceAccountName": "model-sa",
+			"model": map[string]any{
+				"modelFormat": map[string]any{"name": "onnx"},
+				"runtime":     "ovms",
+				"storageUri":  "pvc://model-pvc/models/mnist",
+				"storage": map[string]any{
+					"key":  "aws-connection-models",
+					"path": "mnist",
+				},
+			},
+			"volumes": []any{
+				map[string]any{
+					"name":      "config",
+					"configMap": map[string]any{"name": "model-config"},
+				},
+			},
+		},
+	}
+
+	sa := createObject(resources.ServiceAccount, "model-sa", "default")
+	sa.Object["secrets"] = []any{
+		map[string]any{"name": "s3-credentials"},
+		map[string]any{"name": "model-sa-token-x7k2p"},
+		map[string]any{"name": "model-sa-dockercfg-9qz4d"},
+	}
+
+	fakeClient := createFakeClient(t,
+		isvc,
+		sa,
+		createObject(resources.ServingRuntime, "ovms", "default"),
+		createObject(resources.Secret, "aws-connection-models", "default"),
+		createObject(resources.Secret, "storage-config", "default"),
+		createObject(resources.Secret, "s3-credentials", "default"),
+		createObject(resources.PersistentVolumeClaim, "model-pvc", "default"),
+		createObject(resources.ConfigMap, "model-config", "default"),
+	)
+
+	deps, err := inferenceservice.NewResolver().Resolve(ctx, fakeClient, isvc)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(deps).To(ConsistOf(
+		resolved(resources.ServingRuntime, "ovms"),
+		resolved(resources.Secret, "aws-connection-models"),
+		resolved(resources.Secret, "storage-config"),
+		resolved(resources.Secret, "s3-credentials"),
+		resolved(resources.ServiceAccount, "model-sa"),
+		resolved(resources.PersistentVolumeClaim, "model-pvc"),
+		resolved(resources.ConfigMap, "model-config"),
+	))
+}
+
+func TestResolverWithMissingStorageSecret(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	isvc := createObject(resources.InferenceService, "model", "default")
+	isvc.Object["spec"] = map[string]any{
+		"predictor": map[string]any{
+			"serviceAccountName": "default",
+			"model": map[string]any{
+				"storage": map[string]any{"key": "aws-connection-models"},
+			},
+		},
+	}
+
+	fakeClient := createFakeClient(t, isvc, createObject(resources.Secret, "storage-config", "default"))
+
+	deps, err := inferenceservice.NewResolver().Resolve(ctx, fakeClient, isvc)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(deps).To(ConsistOf(
+		resolved(resources.Secret, "storage-config"),
+		And(
+			HaveField("GVR", resources.Secret.GVR()),
+			HaveField("Name", "aws-connection-models"),
+			HaveField("Resource", BeNil()),
+			HaveField("Error", HaveOccurred()),
+		),
+	))
+}
+
+func TestResolverWithoutDependencies(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	isvc := createObject(resources.InferenceService, "model", "default")
+	isvc.Object["spec"] = map[string]any{
+		"predictor": map[string]any{
+			"model": map[string]any{"storageUri": "s3://models/mnist"},
+		},
+	}
+
+	fakeClient := createFakeClient(t, isvc)
+
+	deps, err := inferenceservice.NewResolver().Resolve(ctx, fakeClient, isvc)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(deps).To(BeEmpty())
+}
+
+func resolved(resourceType resources.ResourceType, name string) types.GomegaMatcher {
+	return And(
+		HaveField("GVR", resourceType.GVR()),
+		HaveField("Name", name),
+		HaveField("Error", BeNil()),
+	)
+}
+
+func createObject(
+	resourceType resources.ResourceType,
+	name string,
+	namespace string,
+) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(resourceType.GVK())
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+
+	return obj
+}
+
+func createFakeClient(
+	t *testing.T,
+	objs ...runtime.Object,
+) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, objs...)
+
+	return client.NewForTesting(client.TestClientConfig{
+		Dynamic: dynamicClient,
+	})
+}
@@ -0,0 +1,125 @@
+package llamastack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+const (
+	// storagePVCSuffix is appended to the distribution name for the PVC the operator
+	// creates when .spec.server.storage is set.
+	storagePVCSuffix        = "-pvc"
+	pathContainerSpec       = ".spec.server.containerSpec"
+	pathVolumes             = ".spec.server.podOverrides.volumes // []"
+	pathStorage             = ".spec.server.storage"
+	pathUserConfig          = ".spec.server.userConfig.configMapName"
+	pathUserConfigNamespace = ".spec.server.userConfig.configMapNamespace"
+	pathCABundle            = ".spec.server.tlsConfig.caBundle.configMapName"
+	pathCABundleNamespace   = ".spec.server.tlsConfig.caBundle.configMapNamespace"
+)
+
+// Resolver resolves dependencies for LlamaStackDistributions.
+type Resolver struct{}
+
+// NewResolver creates a new LlamaStackDistribution dependency resolver.
+func NewResolver() *Resolver {
+	return &Resolver{}
+}
+
+// CanHandle returns true for LlamaStackDistribution resources.
+func (r *Resolver) CanHandle(gvr schema.GroupVersionResource) bool {
+	return gvr.Group == resources.LlamaStackDistribution.Group &&
+		gvr.Resource == resources.LlamaStackDistribution.Resource
+}
+
+// Resolve finds all dependencies for a LlamaStackDistribution: the run configuration
+// and CA bundle ConfigMaps, the storage PVC, and everything referenced by the server
+// container and pod override volumes.
+func (r *Resolver) Resolve(
+	ctx context.Context,
+	c client.Reader,
+	obj *unstructured.Unstructured,
+) ([]dependencies.Dependency, error) {
+	namespace := obj.GetNamespace()
+
+	spec, err := r.extractPodSpec(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	allDeps, err := dependencies.ResolvePodSpecs(ctx, c, namespace, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	configMapDeps, err := dependencies.ResolveByName(ctx, c, namespace, resources.ConfigMap,
+		r.localConfigMap(obj, pathUserConfig, pathUserConfigNamespace),
+		r.localConfigMap(obj, pathCABundle, pathCABundleNamespace),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("resolving ConfigMaps: %w", err)
+	}
+	allDeps = append(allDeps, configMapDeps...)
+
+	if storage, err := jq.Query[map[string]any](obj, pathStorage); err == nil && storage != nil {
+		pvcDeps, err := dependencies.ResolveByName(ctx, c, namespace, resources.PersistentVolumeClaim,
+			obj.GetName()+storagePVCSuffix)
+		if err != nil {
+			return nil, fmt.Errorf("resolving PVCs: %w", err)
+		}
+		allDeps = append(allDeps, pvcDeps...)
+	}
+
+	return dependencies.Unique(allDeps), nil
+}
+
+// extractPodSpec returns a pod spec holding the server container and the pod override
+// volumes, so that they can be resolved like any other pod.
+func (r *Resolver) extractPodSpec(obj *unstructured.Unstructured) (corev1.PodSpec, error) {
+	var spec corev1.PodSpec
+
+	container, err := jq.Query[corev1.Container](obj, pathContainerSpec)
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return spec, fmt.Errorf("querying container spec: %w", err)
+	}
+	if err == nil {
+		spec.Containers = []corev1.Container{container}
+	}
+
+	spec.Volumes, err = jq.Query[[]corev1.Volume](obj, pathVolumes)
+	if err != nil && !errors.Is(err, jq.ErrNotFound) {
+		return spec, fmt.Errorf("querying volumes: %w", err)
+	}
+
+	return spec, nil
+}
+
+// localConfigMap returns the ConfigMap name at path, or "" when the ConfigMap lives
+// in another namespace and is therefore not part of the workload.
+func (r *Resolver) localConfigMap(obj *unstructured.Unstructured, path string, namespacePath string) string {
+	ns := r.queryStringField(obj, namespacePath)
+	if ns != "" && ns != obj.GetNamespace() {
+		return ""
+	}
+
+	return r.queryStringField(obj, path)
+}
+
+func (r *Resolver) queryStringField(obj *unstructured.Unstructured, path string) string {
+	value, err := jq.Query[string](obj, path)
+	if err != nil {
+		return ""
+	}
+
+	return value
+}
@@ -0,0 +1,145 @@
+package llamastack_test
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega/types"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies/llamastack"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.ConfigMap.GVR():             "ConfigMapList",
+	resources.Secret.GVR():                "SecretList",
+	resources.PersistentVolumeClaim.GVR(): "PersistentVolumeClaimList",
+	resources.ServiceAccount.GVR():        "ServiceAccountList",
+}
+
+func TestResolverCanHandle(t *testing.T) {
+	g := NewWithT(t)
+
+	resolver := llamastack.NewResolver()
+
+	g.Expect(resolver.CanHandle(resources.LlamaStackDistribution.GVR())).To(BeTrue())
+	g.Expect(resolver.CanHandle(resources.InferenceService.GVR())).To(BeFalse())
+}
+
+func TestResolverWithServerConfig(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	lsd := createObject(resources.LlamaStackDistribution, "llama", "default")
+	lsd.Object["spec"] = map[string]any{
+		"server": map[string]any{
+			"containerSpec": map[string]any{
+				"name": "llama-stack",
+				"env": []any{
+					map[string]any{
+						"name": "VLLM_API_TOKEN",
+						"valueFrom": map[string]any{
+							"secretKeyRef": map[string]any{"name": "vllm-token", "key": "token"},
+						},
+					},
+				},
+			},
+			"podOverrides": map[string]any{
+				"volumes": []any{
+					map[string]any{
+						"name":      "providers",
+						"configMap": map[string]any{"name": "providers"},
+					},
+				},
+			},
+			"userConfig": map[string]any{"configMapName": "llama-run-config"},
+			"tlsConfig": map[string]any{
+				"caBundle": map[string]any{
+					"configMapName":      "cluster-ca",
+					"configMapNamespace": "openshift-config",
+				},
+			},
+			"storage": map[string]any{"size": "10Gi"},
+		},
+	}
+
+	fakeClient := createFakeClient(t,
+		lsd,
+		createObject(resources.Secret, "vllm-token", "default"),
+		createObject(resources.ConfigMap, "providers", "default"),
+		createObject(resources.ConfigMap, "llama-run-config", "default"),
+		createObject(resources.PersistentVolumeClaim, "llama-pvc", "default"),
+	)
+
+	deps, err := llamastack.NewResolver().Resolve(ctx, fakeClient, lsd)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(deps).To(ConsistOf(
+		resolved(resources.Secret, "vllm-token"),
+		resolved(resources.ConfigMap, "providers"),
+		resolved(resources.ConfigMap, "llama-run-config"),
+		resolved(resources.PersistentVolumeClaim, "llama-pvc"),
+	))
+}
+
+func TestResolverWithoutStorage(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	lsd := createObject(resources.LlamaStackDistribution, "llama", "default")
+	lsd.Object["spec"] = map[string]any{
+		"server": map[string]any{
+			"distribution": map[string]any{"name": "rh-dev"},
+		},
+	}
+
+	fakeClient := createFakeClient(t, lsd, createObject(resources.PersistentVolumeClaim, "llama-pvc", "default"))
+
+	deps, err := llamastack.NewResolver().Resolve(ctx, fakeClient, lsd)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(deps).To(BeEmpty())
+}
+
+func resolved(resourceType resources.ResourceType, name string) types.GomegaMatcher {
+	return And(
+		HaveField("GVR", resourceType.GVR()),
+		HaveField("Name", name),
+		HaveField("Error", BeNil()),
+	)
+}
+
+func createObject(
+	resourceType resources.ResourceType,
+	name string,
+	namespace string,
+) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(resourceType.GVK())
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+
+	return obj
+}
+
+func createFakeClient(
+	t *testing.T,
+	objs ...runtime.Object,
+) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, objs...)
+
+	return client.NewForTesting(client.TestClientConfig{
+		Dynamic: dynamicClient,
+	})
+}
@@ -0,0 +1,43 @@
+package pytorchjob
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+// pathPodSpecs selects the pod template of every replica type (Master, Worker).
+const pathPodSpecs = "[.spec.pytorchReplicaSpecs[]?.template.spec]"
+
+// Resolver resolves dependencies for Kubeflow Training PyTorchJobs.
+type Resolver struct{}
+
+// NewResolver creates a new PyTorchJob dependency resolver.
+func NewResolver() *Resolver {
+	return &Resolver{}
+}
+
+// CanHandle returns true for PyTorchJob resources.
+func (r *Resolver) CanHandle(gvr schema.GroupVersionResource) bool {
+	return gvr.Group == resources.PyTorchJob.Group && gvr.Resource == resources.PyTorchJob.Resource
+}
+
+// Resolve finds the ConfigMaps, Secrets, PVCs and ServiceAccounts referenced by the
+// replica pod templates of a PyTorchJob.
+func (r *Resolver) Resolve(
+	ctx context.Context,
+	c client.Reader,
+	obj *unstructured.Unstructured,
+) ([]dependencies.Dependency, error) {
+	specs, err := dependencies.QueryPodSpecs(obj, pathPodSpecs)
+	if err != nil {
+		return nil, err
+	}
+
+	return dependencies.ResolvePodSpecs(ctx, c, obj.GetNamespace(), specs...)
+}
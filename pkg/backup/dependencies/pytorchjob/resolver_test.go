@@ -0,0 +1,132 @@
+package pytorchjob_test
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega/types"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies/pytorchjob"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.ConfigMap.GVR():             "ConfigMapList",
+	resources.Secret.GVR():                "SecretList",
+	resources.PersistentVolumeClaim.GVR(): "PersistentVolumeClaimList",
+	resources.ServiceAccount.GVR():        "ServiceAccountList",
+}
+
+func TestResolverCanHandle(t *testing.T) {
+	g := NewWithT(t)
+
+	resolver := pytorchjob.NewResolver()
+
+	g.Expect(resolver.CanHandle(resources.PyTorchJob.GVR())).To(BeTrue())
+	g.Expect(resolver.CanHandle(resources.Notebook.GVR())).To(BeFalse())
+}
+
+func TestResolverWithReplicaSpecs(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	job := createObject(resources.PyTorchJob, "train", "default")
+	job.Object["spec"] = map[string]any{
+		"pytorchReplicaSpecs": map[string]any{
+			"Master": replicaSpec(map[string]any{
+				"name": "HF_TOKEN",
+				"valueFrom": map[string]any{
+					"secretKeyRef": map[string]any{"name": "hf-token", "key": "token"},
+				},
+			}),
+			"Worker": replicaSpec(map[string]any{
+				"name": "CONFIG",
+				"valueFrom": map[string]any{
+					"configMapKeyRef": map[string]any{"name": "train-config", "key": "config"},
+				},
+			}),
+		},
+	}
+
+	fakeClient := createFakeClient(t,
+		job,
+		createObject(resources.Secret, "hf-token", "default"),
+		createObject(resources.ConfigMap, "train-config", "default"),
+		createObject(resources.PersistentVolumeClaim, "checkpoints", "default"),
+	)
+
+	deps, err := pytorchjob.NewResolver().Resolve(ctx, fakeClient, job)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(deps).To(ConsistOf(
+		resolved(resources.Secret, "hf-token"),
+		resolved(resources.ConfigMap, "train-config"),
+		resolved(resources.PersistentVolumeClaim, "checkpoints"),
+	))
+}
+
+func replicaSpec(env map[string]any) map[string]any {
+	return map[string]any{
+		"replicas": int64(1),
+		"template": map[string]any{
+			"spec": map[string]any{
+				"containers": []any{
+					map[string]any{
+						"name":  "pytorch",
+						"image": "pytorch:latest",
+						"env":   []any{env},
+					},
+				},
+				"volumes": []any{
+					map[string]any{
+						"name":                  "checkpoints",
+						"persistentVolumeClaim": map[string]any{"claimName": "checkpoints"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resolved(resourceType resources.ResourceType, name string) types.GomegaMatcher {
+	return And(
+		HaveField("GVR", resourceType.GVR()),
+		HaveField("Name", name),
+		HaveField("Error", BeNil()),
+	)
+}
+
+func createObject(
+	resourceType resources.ResourceType,
+	name string,
+	namespace string,
+) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(resourceType.GVK())
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+
+	return obj
+}
+
+func createFakeClient(
+	t *testing.T,
+	objs ...runtime.Object,
+) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, objs...)
+
+	return client.NewForTesting(client.TestClientConfig{
+		Dynamic: dynamicClient,
+	})
+}
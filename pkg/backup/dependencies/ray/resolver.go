@@ -0,0 +1,56 @@
+package ray
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+const (
+	pathRayClusterPodSpecs = "[.spec.headGroupSpec.template.spec, .spec.workerGroupSpecs[]?.template.spec]"
+	pathRayJobPodSpecs     = "[.spec.rayClusterSpec.headGroupSpec.template.spec," +
+		" .spec.rayClusterSpec.workerGroupSpecs[]?.template.spec," +
+		" .spec.submitterPodTemplate.spec]"
+)
+
+// Resolver resolves dependencies for RayClusters and RayJobs.
+type Resolver struct{}
+
+// NewResolver creates a new Ray dependency resolver.
+func NewResolver() *Resolver {
+	return &Resolver{}
+}
+
+// CanHandle returns true for RayCluster and RayJob resources.
+func (r *Resolver) CanHandle(gvr schema.GroupVersionResource) bool {
+	if gvr.Group != resources.RayCluster.Group {
+		return false
+	}
+
+	return gvr.Resource == resources.RayCluster.Resource || gvr.Resource == resources.RayJob.Resource
+}
+
+// Resolve finds the ConfigMaps, Secrets, PVCs and ServiceAccounts referenced by the
+// head and worker group pod templates, and for RayJobs by the submitter pod template.
+func (r *Resolver) Resolve(
+	ctx context.Context,
+	c client.Reader,
+	obj *unstructured.Unstructured,
+) ([]dependencies.Dependency, error) {
+	path := pathRayClusterPodSpecs
+	if obj.GetKind() == resources.RayJob.Kind {
+		path = pathRayJobPodSpecs
+	}
+
+	specs, err := dependencies.QueryPodSpecs(obj, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return dependencies.ResolvePodSpecs(ctx, c, obj.GetNamespace(), specs...)
+}
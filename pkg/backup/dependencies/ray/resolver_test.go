@@ -0,0 +1,162 @@
+package ray_test
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega/types"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies/ray"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.ConfigMap.GVR():             "ConfigMapList",
+	resources.Secret.GVR():                "SecretList",
+	resources.PersistentVolumeClaim.GVR(): "PersistentVolumeClaimList",
+	resources.ServiceAccount.GVR():        "ServiceAccountList",
+}
+
+func TestResolverCanHandle(t *testing.T) {
+	g := NewWithT(t)
+
+	resolver := ray.NewResolver()
+
+	g.Expect(resolver.CanHandle(resources.RayCluster.GVR())).To(BeTrue())
+	g.Expect(resolver.CanHandle(resources.RayJob.GVR())).To(BeTrue())
+	g.Expect(resolver.CanHandle(resources.PyTorchJob.GVR())).To(BeFalse())
+}
+
+func TestResolverWithRayCluster(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	cluster := createObject(resources.RayCluster, "raycluster", "default")
+	cluster.Object["spec"] = map[string]any{
+		"headGroupSpec": map[string]any{
+			"template": podTemplate("head", "default", map[string]any{
+				"name":   "ca",
+				"secret": map[string]any{"secretName": "ray-tls"},
+			}),
+		},
+		"workerGroupSpecs": []any{
+			map[string]any{
+				"groupName": "workers",
+				"template": podTemplate("workers", "ray-worker", map[string]any{
+					"name":                  "data",
+					"persistentVolumeClaim": map[string]any{"claimName": "ray-data"},
+				}),
+			},
+		},
+	}
+
+	fakeClient := createFakeClient(t,
+		cluster,
+		createObject(resources.Secret, "ray-tls", "default"),
+		createObject(resources.PersistentVolumeClaim, "ray-data", "default"),
+		createObject(resources.ServiceAccount, "ray-worker", "default"),
+	)
+
+	deps, err := ray.NewResolver().Resolve(ctx, fakeClient, cluster)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(deps).To(ConsistOf(
+		resolved(resources.Secret, "ray-tls"),
+		resolved(resources.PersistentVolumeClaim, "ray-data"),
+		resolved(resources.ServiceAccount, "ray-worker"),
+	))
+}
+
+func TestResolverWithRayJob(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	job := createObject(resources.RayJob, "rayjob", "default")
+	job.Object["spec"] = map[string]any{
+		"entrypoint": "python train.py",
+		"rayClusterSpec": map[string]any{
+			"headGroupSpec": map[string]any{
+				"template": podTemplate("head", "", map[string]any{
+					"name":      "code",
+					"configMap": map[string]any{"name": "train-code"},
+				}),
+			},
+		},
+		"submitterPodTemplate": podTemplate("submitter", "", map[string]any{
+			"name":   "creds",
+			"secret": map[string]any{"secretName": "submitter-creds"},
+		}),
+	}
+
+	fakeClient := createFakeClient(t,
+		job,
+		createObject(resources.ConfigMap, "train-code", "default"),
+		createObject(resources.Secret, "submitter-creds", "default"),
+	)
+
+	deps, err := ray.NewResolver().Resolve(ctx, fakeClient, job)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(deps).To(ConsistOf(
+		resolved(resources.ConfigMap, "train-code"),
+		resolved(resources.Secret, "submitter-creds"),
+	))
+}
+
+func podTemplate(container string, serviceAccount string, volume map[string]any) map[string]any {
+	spec := map[string]any{
+		"containers": []any{
+			map[string]any{"name": container, "image": "ray:latest"},
+		},
+		"volumes": []any{volume},
+	}
+
+	if serviceAccount != "" {
+		spec["serviceAccountName"] = serviceAccount
+	}
+
+	return map[string]any{"spec": spec}
+}
+
+func resolved(resourceType resources.ResourceType, name string) types.GomegaMatcher {
+	return And(
+		HaveField("GVR", resourceType.GVR()),
+		HaveField("Name", name),
+		HaveField("Error", BeNil()),
+	)
+}
+
+func createObject(
+	resourceType resources.ResourceType,
+	name string,
+	namespace string,
+) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(resourceType.GVK())
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+
+	return obj
+}
+
+func createFakeClient(
+	t *testing.T,
+	objs ...runtime.Object,
+) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, objs...)
+
+	return client.NewForTesting(client.TestClientConfig{
+		Dynamic: dynamicClient,
+	})
+}
@@ -0,0 +1,44 @@
+package servingruntime
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+// pathPodSpecs selects the runtime spec, which embeds the pod spec fields
+// (containers, volumes, serviceAccountName) of the model server.
+const pathPodSpecs = "[.spec]"
+
+// Resolver resolves dependencies for KServe ServingRuntimes.
+type Resolver struct{}
+
+// NewResolver creates a new ServingRuntime dependency resolver.
+func NewResolver() *Resolver {
+	return &Resolver{}
+}
+
+// CanHandle returns true for KServe ServingRuntime resources.
+func (r *Resolver) CanHandle(gvr schema.GroupVersionResource) bool {
+	return gvr.Group == resources.ServingRuntime.Group && gvr.Resource == resources.ServingRuntime.Resource
+}
+
+// Resolve finds the ConfigMaps, Secrets, PVCs and ServiceAccount referenced by the
+// model server containers and volumes of a ServingRuntime.
+func (r *Resolver) Resolve(
+	ctx context.Context,
+	c client.Reader,
+	obj *unstructured.Unstructured,
+) ([]dependencies.Dependency, error) {
+	specs, err := dependencies.QueryPodSpecs(obj, pathPodSpecs)
+	if err != nil {
+		return nil, err
+	}
+
+	return dependencies.ResolvePodSpecs(ctx, c, obj.GetNamespace(), specs...)
+}
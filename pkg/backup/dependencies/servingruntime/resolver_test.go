@@ -0,0 +1,117 @@
+package servingruntime_test
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega/types"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup/dependencies/servingruntime"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+//nolint:gochecknoglobals // Test fixture - shared across test functions
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.ConfigMap.GVR():             "ConfigMapList",
+	resources.Secret.GVR():                "SecretList",
+	resources.PersistentVolumeClaim.GVR(): "PersistentVolumeClaimList",
+	resources.ServiceAccount.GVR():        "ServiceAccountList",
+}
+
+func TestResolverCanHandle(t *testing.T) {
+	g := NewWithT(t)
+
+	resolver := servingruntime.NewResolver()
+
+	g.Expect(resolver.CanHandle(resources.ServingRuntime.GVR())).To(BeTrue())
+	g.Expect(resolver.CanHandle(resources.InferenceService.GVR())).To(BeFalse())
+}
+
+func TestResolverWithContainerAndVolumeRefs(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	sr := createObject(resources.ServingRuntime, "ovms", "default")
+	sr.Object["spec"] = map[string]any{
+		"serviceAccountName": "runtime-sa",
+		"containers": []any{
+			map[string]any{
+				"name":  "kserve-container",
+				"image": "ovms:latest",
+				"envFrom": []any{
+					map[string]any{"secretRef": map[string]any{"name": "runtime-env"}},
+				},
+			},
+		},
+		"volumes": []any{
+			map[string]any{
+				"name":      "config",
+				"configMap": map[string]any{"name": "runtime-config"},
+			},
+			map[string]any{
+				"name":                  "cache",
+				"persistentVolumeClaim": map[string]any{"claimName": "runtime-cache"},
+			},
+		},
+	}
+
+	fakeClient := createFakeClient(t,
+		sr,
+		createObject(resources.Secret, "runtime-env", "default"),
+		createObject(resources.ConfigMap, "runtime-config", "default"),
+		createObject(resources.PersistentVolumeClaim, "runtime-cache", "default"),
+		createObject(resources.ServiceAccount, "runtime-sa", "default"),
+	)
+
+	deps, err := servingruntime.NewResolver().Resolve(ctx, fakeClient, sr)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(deps).To(ConsistOf(
+		resolved(resources.Secret, "runtime-env"),
+		resolved(resources.ConfigMap, "runtime-config"),
+		resolved(resources.PersistentVolumeClaim, "runtime-cache"),
+		resolved(resources.ServiceAccount, "runtime-sa"),
+	))
+}
+
+func resolved(resourceType resources.ResourceType, name string) types.GomegaMatcher {
+	return And(
+		HaveField("GVR", resourceType.GVR()),
+		HaveField("Name", name),
+		HaveField("Error", BeNil()),
+	)
+}
+
+func createObject(
+	resourceType resources.ResourceType,
+	name string,
+	namespace string,
+) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(resourceType.GVK())
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+
+	return obj
+}
+
+func createFakeClient(
+	t *testing.T,
+	objs ...runtime.Object,
+) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, objs...)
+
+	return client.NewForTesting(client.TestClientConfig{
+		Dynamic: dynamicClient,
+	})
+}
@@ -0,0 +1,247 @@
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	cliversion "github.com/opendatahub-io/odh-cli/internal/version"
+	"github.com/opendatahub-io/odh-cli/pkg/backup/pipeline"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// ManifestFileName is the name of the manifest at the root of a backup.
+const ManifestFileName = "manifest.json"
+
+// Manifest records where a backup came from and every file it contains.
+type Manifest struct {
+	// CLIVersion is the version of the CLI that wrote the backup.
+	CLIVersion string `json:"cliVersion"`
+
+	// CreatedAt is the time the backup was written.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// APIServer is the API server address of the source cluster.
+	APIServer string `json:"apiServer"`
+
+	// RHOAIVersion and OpenShiftVersion are the versions detected on the source
+	// cluster, empty when they could not be detected.
+	RHOAIVersion     string `json:"rhoaiVersion,omitempty"`
+	OpenShiftVersion string `json:"openShiftVersion,omitempty"`
+
+	// Settings are the options the backup was taken with.
+	Settings ManifestSettings `json:"settings"`
+
+	// Files are the backed up resources, sorted by path.
+	Files []ManifestFile `json:"files"`
+}
+
+// ManifestSettings are the backup options recorded in the manifest.
+type ManifestSettings struct {
+	Includes        []string     `json:"includes"`
+	Excludes        []string     `json:"excludes,omitempty"`
+	AllODHWorkloads bool         `json:"allOdhWorkloads,omitempty"`
+	StripFields     []string     `json:"stripFields"`
+	Dependencies    bool         `json:"dependencies"`
+	Secrets         SecretPolicy `json:"secrets"`
+}
+
+// ManifestFile is one backed up resource.
+type ManifestFile struct {
+	// Path is the slash-separated location of the file relative to the backup root.
+	Path string `json:"path"`
+
+	Group     string `json:"group,omitempty"`
+	Version   string `json:"version"`
+	Resource  string `json:"resource"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+
+	// SHA256 is the hex-encoded SHA-256 digest of the file content.
+	SHA256 string `json:"sha256"`
+
+	// Dependencies are the paths of the Secrets, ConfigMaps and PVCs this workload
+	// references.
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+// GVR returns the resource type of the file.
+func (f ManifestFile) GVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: f.Group, Version: f.Version, Resource: f.Resource}
+}
+
+// manifestRecorder collects the files written by a backup and the dependency edges
+// between them.
+type manifestRecorder struct {
+	dir  string
+	path func(gvr schema.GroupVersionResource, obj *unstructured.Unstructured) string
+
+	mu    sync.Mutex
+	files map[string]*ManifestFile
+}
+
+// newManifestRecorder returns a recorder for files written to dir, whose relative
+// location is given by path.
+func newManifestRecorder(
+	dir string,
+	path func(gvr schema.GroupVersionResource, obj *unstructured.Unstructured) string,
+) *manifestRecorder {
+	return &manifestRecorder{
+		dir:   dir,
+		path:  path,
+		files: make(map[string]*ManifestFile),
+	}
+}
+
+// recordFile records a resource written to the backup directory, hashing the file
+// as it is on disk.
+func (r *manifestRecorder) recordFile(gvr schema.GroupVersionResource, obj *unstructured.Unstructured) error {
+	rel := r.path(gvr, obj)
+
+	sum, err := hashFile(filepath.Join(r.dir, filepath.FromSlash(rel)))
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	file, ok := r.files[rel]
+	if !ok {
+		file = &ManifestFile{
+			Path:      rel,
+			Group:     gvr.Group,
+			Version:   gvr.Version,
+			Resource:  gvr.Resource,
+			Namespace: obj.GetNamespace(),
+			Name:      obj.GetName(),
+		}
+		r.files[rel] = file
+	}
+
+	file.SHA256 = sum
+
+	return nil
+}
+
+// recordDependencies records the edges from a written workload to its dependencies.
+// Dependencies that were not written are left out.
+func (r *manifestRecorder) recordDependencies(item pipeline.WorkloadWithDeps) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	workload, ok := r.files[r.path(item.GVR, item.Instance)]
+	if !ok {
+		return
+	}
+
+	for _, dep := range item.Dependencies {
+		if dep.Error != nil {
+			continue
+		}
+
+		rel := r.path(dep.GVR, dep.Resource)
+		if _, ok := r.files[rel]; ok && !slices.Contains(workload.Dependencies, rel) {
+			workload.Dependencies = append(workload.Dependencies, rel)
+		}
+	}
+
+	slices.Sort(workload.Dependencies)
+}
+
+// manifestFiles returns the recorded files sorted by path.
+func (r *manifestRecorder) manifestFiles() []ManifestFile {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := make([]ManifestFile, 0, len(r.files))
+	for _, file := range r.files {
+		result = append(result, *file)
+	}
+
+	slices.SortFunc(result, func(a, b ManifestFile) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return result
+}
+
+// buildManifest describes the backup written by c, detecting the source cluster versions.
+// Versions that cannot be detected are left empty.
+func (c *Command) buildManifest(ctx context.Context, files []ManifestFile) *Manifest {
+	manifest := &Manifest{
+		CLIVersion: cliversion.GetVersion(),
+		CreatedAt:  time.Now().UTC(),
+		APIServer:  c.APIServer,
+		Settings: ManifestSettings{
+			Includes:        c.Includes,
+			Excludes:        c.Excludes,
+			AllODHWorkloads: c.AllODHWorkloads,
+			StripFields:     c.StripFields,
+			Dependencies:    c.Dependencies,
+			Secrets:         c.Secrets,
+		},
+		Files: files,
+	}
+
+	if v, err := version.Detect(ctx, c.Client); err == nil {
+		manifest.RHOAIVersion = v.String()
+	} else if c.Verbose {
+		c.IO.Errorf("Warning: Failed to detect the RHOAI version: %v", err)
+	}
+
+	if v, err := version.DetectOpenShiftVersion(ctx, c.Client); err == nil {
+		manifest.OpenShiftVersion = v.String()
+	} else if c.Verbose {
+		c.IO.Errorf("Warning: Failed to detect the OpenShift version: %v", err)
+	}
+
+	return manifest
+}
+
+// WriteManifest writes manifest as indented JSON to $dir/manifest.json.
+func WriteManifest(dir string, manifest *Manifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling manifest: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, ManifestFileName), append(data, '\n'), filePermissions); err != nil {
+		return fmt.Errorf("writing manifest: %w", err)
+	}
+
+	return nil
+}
+
+// hashFile returns the hex-encoded SHA-256 digest of the file at path.
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("opening file: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	return hashReader(f)
+}
+
+func hashReader(rd io.Reader) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, rd); err != nil {
+		return "", fmt.Errorf("hashing file: %w", err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
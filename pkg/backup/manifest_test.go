@@ -0,0 +1,261 @@
+package backup_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	notebookPath = restoreNamespace + "/notebooks.kubeflow.org-wb.yaml"
+	secretPath   = restoreNamespace + "/secrets-wb-secret.yaml"
+
+	// secretToken is the base64-encoded value of the backed up Secret.
+	secretToken = "czNjcjN0"
+)
+
+// newBackupClient returns a client serving a notebook that mounts a Secret, and a
+// DataScienceCluster reporting version 2.25.0.
+func newBackupClient() client.Client {
+	notebook := newObject("kubeflow.org/v1", "Notebook", restoreNamespace, "wb")
+	notebook.Object["spec"] = map[string]any{
+		"template": map[string]any{
+			"spec": map[string]any{
+				"volumes": []any{
+					map[string]any{"name": "creds", "secret": map[string]any{"secretName": "wb-secret"}},
+				},
+				"containers": []any{
+					map[string]any{"name": "wb", "image": "workbench:latest"},
+				},
+			},
+		},
+	}
+
+	secret := newObject("v1", "Secret", restoreNamespace, "wb-secret")
+	secret.Object["data"] = map[string]any{"token": secretToken}
+
+	dsc := newObject(resources.DataScienceCluster.APIVersion(), resources.DataScienceCluster.Kind, "", "default-dsc")
+	_ = unstructured.SetNestedField(dsc.Object, "2.25.0", "status", "release", "version")
+
+	scheme := runtime.NewScheme()
+	dyn := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, map[schema.GroupVersionResource]string{
+		notebookGVR:                           "NotebookList",
+		resources.Secret.GVR():                resources.Secret.ListKind(),
+		resources.ConfigMap.GVR():             resources.ConfigMap.ListKind(),
+		resources.PersistentVolumeClaim.GVR(): resources.PersistentVolumeClaim.ListKind(),
+		resources.DataScienceCluster.GVR():    resources.DataScienceCluster.ListKind(),
+		resources.DSCInitialization.GVR():     resources.DSCInitialization.ListKind(),
+	}, notebook, secret, dsc)
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	for _, rt := range []resources.ResourceType{
+		resources.Notebook,
+		resources.Secret,
+		resources.ConfigMap,
+		resources.PersistentVolumeClaim,
+	} {
+		mapper.Add(rt.GVK(), meta.RESTScopeNamespace)
+	}
+
+	return client.NewForTesting(client.TestClientConfig{
+		Dynamic:    dyn,
+		RESTMapper: mapper,
+	})
+}
+
+// runBackup runs a notebook backup against newBackupClient. configure sets further
+// options before the command is completed.
+func runBackup(t *testing.T, outputDir string, archive string, configure ...func(*backup.Command)) {
+	t.Helper()
+
+	g := NewWithT(t)
+
+	command := backup.NewCommand(genericiooptions.IOStreams{
+		In:     &bytes.Buffer{},
+		Out:    &bytes.Buffer{},
+		ErrOut: &bytes.Buffer{},
+	})
+	command.OutputDir = outputDir
+	command.Archive = archive
+	command.Includes = []string{"notebooks.kubeflow.org"}
+
+	for _, fn := range configure {
+		fn(command)
+	}
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+
+	command.Client = newBackupClient()
+	command.APIServer = "https://api.source.example.com:6443"
+
+	g.Expect(command.Run(t.Context())).To(Succeed())
+}
+
+func readManifest(t *testing.T, dir string) backup.Manifest {
+	t.Helper()
+
+	g := NewWithT(t)
+
+	data, err := os.ReadFile(filepath.Join(dir, backup.ManifestFileName))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	var manifest backup.Manifest
+	g.Expect(json.Unmarshal(data, &manifest)).To(Succeed())
+
+	return manifest
+}
+
+func TestCommand_RunWritesManifest(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	runBackup(t, dir, "")
+
+	manifest := readManifest(t, dir)
+	g.Expect(manifest.APIServer).To(Equal("https://api.source.example.com:6443"))
+	g.Expect(manifest.RHOAIVersion).To(Equal("2.25.0"))
+	g.Expect(manifest.OpenShiftVersion).To(BeEmpty())
+	g.Expect(manifest.Settings.Includes).To(Equal([]string{"notebooks.kubeflow.org"}))
+	g.Expect(manifest.Settings.StripFields).To(Equal(backup.DefaultStripFields))
+
+	g.Expect(manifest.Files).To(HaveExactElements(
+		And(
+			HaveField("Path", notebookPath),
+			HaveField("GVR()", notebookGVR),
+			HaveField("Namespace", restoreNamespace),
+			HaveField("Name", "wb"),
+			HaveField("Dependencies", []string{secretPath}),
+		),
+		And(
+			HaveField("Path", secretPath),
+			HaveField("GVR()", resources.Secret.GVR()),
+			HaveField("Dependencies", BeEmpty()),
+		),
+	))
+
+	result, err := backup.Verify(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Problems).To(BeEmpty())
+	g.Expect(result.Verified).To(Equal(2))
+}
+
+func TestCommand_RunWritesArchive(t *testing.T) {
+	g := NewWithT(t)
+
+	archive := filepath.Join(t.TempDir(), "backup.tar.gz")
+	runBackup(t, "", archive)
+
+	result, err := backup.Verify(archive)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Problems).To(BeEmpty())
+	g.Expect(result.Verified).To(Equal(2))
+	g.Expect(result.Manifest.RHOAIVersion).To(Equal("2.25.0"))
+}
+
+func TestRestoreCommand_RunFromArchive(t *testing.T) {
+	g := NewWithT(t)
+
+	archive := filepath.Join(t.TempDir(), "backup.tgz")
+	runBackup(t, "", archive)
+
+	cl, dyn := newRestoreClient()
+	command, out := newRestoreCommand(t, archive, cl)
+
+	g.Expect(command.Run(t.Context())).To(Succeed())
+	g.Expect(decodeReport(t, out).Results).To(HaveExactElements(
+		And(HaveField("Resource", "secrets"), HaveField("Action", backup.RestoreCreated)),
+		And(HaveField("Resource", "notebooks.kubeflow.org"), HaveField("Action", backup.RestoreCreated)),
+	))
+
+	_, err := dyn.Resource(notebookGVR).Namespace(restoreNamespace).Get(t.Context(), "wb", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	secret, err := dyn.Resource(resources.Secret.GVR()).Namespace(restoreNamespace).Get(t.Context(), "wb-secret", metav1.GetOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(secret.Object).To(HaveKeyWithValue("data", HaveKeyWithValue("token", secretToken)))
+}
+
+func TestCommand_ValidateArchive(t *testing.T) {
+	g := NewWithT(t)
+
+	command := backup.NewCommand(genericiooptions.NewTestIOStreamsDiscard())
+	command.Archive = "backup.zip"
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("invalid --archive")))
+
+	command.Archive = "backup.tgz"
+	g.Expect(command.Validate()).To(Succeed())
+}
+
+func TestVerify(t *testing.T) {
+	t.Run("should report modified, missing and unexpected files", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		runBackup(t, dir, "")
+
+		g.Expect(os.WriteFile(filepath.Join(dir, filepath.FromSlash(notebookPath)), []byte("kind: Notebook\n"), 0o600)).To(Succeed())
+		g.Expect(os.Remove(filepath.Join(dir, filepath.FromSlash(secretPath)))).To(Succeed())
+		g.Expect(os.WriteFile(filepath.Join(dir, restoreNamespace, "extra.yaml"), nil, 0o600)).To(Succeed())
+
+		result, err := backup.Verify(dir)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Verified).To(BeZero())
+		g.Expect(result.Problems).To(HaveExactElements(
+			"checksum mismatch: "+notebookPath,
+			"missing file: "+secretPath,
+			"unexpected file: "+restoreNamespace+"/extra.yaml",
+		))
+	})
+
+	t.Run("should fail without a manifest", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := backup.Verify(writeBackup(t))
+		g.Expect(err).To(MatchError(ContainSubstring("no manifest.json found")))
+	})
+}
+
+func TestVerifyCommand_Run(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	runBackup(t, dir, "")
+
+	var out bytes.Buffer
+
+	command := backup.NewVerifyCommand(genericiooptions.IOStreams{
+		In:     &bytes.Buffer{},
+		Out:    &out,
+		ErrOut: &bytes.Buffer{},
+	})
+	command.Path = dir
+	command.Verbose = true
+
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(Succeed())
+	g.Expect(out.String()).To(And(
+		ContainSubstring("Backup of https://api.source.example.com:6443"),
+		ContainSubstring("RHOAI version:     2.25.0"),
+		ContainSubstring("2 of 2 files verified, 0 problems"),
+	))
+
+	g.Expect(os.Remove(filepath.Join(dir, filepath.FromSlash(secretPath)))).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(MatchError(ContainSubstring("does not match its manifest")))
+}
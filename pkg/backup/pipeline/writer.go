@@ -20,6 +20,14 @@ type WriterStage struct {
 	IO            iostreams.Interface
 	DryRun        bool   // Enable dry-run mode with grouped output
 	OutputDir     string // Output directory for path generation (empty = stdout)
+
+	// FileSuffix, if set, returns a suffix appended to the file names of a resource
+	// type in dry-run output, such as ".age" for encrypted resources.
+	FileSuffix func(gvr schema.GroupVersionResource) string
+
+	// Written, if set, is called after a workload and its dependencies were written.
+	// It is not called in dry-run mode or when the workload could not be written.
+	Written func(item WorkloadWithDeps)
 }
 
 // Run reads from input channel and writes sequentially.
@@ -70,6 +78,10 @@ func (w *WriterStage) writeWorkloadWithDeps(item WorkloadWithDeps) error {
 		}
 	}
 
+	if w.Written != nil {
+		w.Written(item)
+	}
+
 	return nil
 }
 
@@ -125,6 +137,9 @@ func (w *WriterStage) getResourcePath(
 	}
 
 	filename := fmt.Sprintf("%s-%s.yaml", gvrStr, name)
+	if w.FileSuffix != nil {
+		filename += w.FileSuffix(gvr)
+	}
 
 	return filepath.Join(w.OutputDir, namespace, filename)
 }
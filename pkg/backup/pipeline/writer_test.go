@@ -86,6 +86,36 @@ func TestWriterStage(t *testing.T) {
 		g.Expect(writtenResources[2]).To(Equal(testNamespace + "/dep-2"))
 	})
 
+	t.Run("should report written workloads", func(t *testing.T) {
+		io := iostreams.NewIOStreams(nil, nil, nil)
+		var written []string
+
+		writer := &pipeline.WriterStage{
+			WriteResource: func(gvr schema.GroupVersionResource, obj *unstructured.Unstructured) error {
+				if obj.GetName() == "broken" {
+					return errors.New("write error")
+				}
+
+				return nil
+			},
+			IO: io,
+			Written: func(item pipeline.WorkloadWithDeps) {
+				written = append(written, item.Instance.GetName())
+			},
+		}
+
+		broken := createTestWorkload()
+		broken.SetName("broken")
+
+		input := make(chan pipeline.WorkloadWithDeps, 2)
+		input <- pipeline.WorkloadWithDeps{Instance: createTestWorkload()}
+		input <- pipeline.WorkloadWithDeps{Instance: broken}
+		close(input)
+
+		g.Expect(writer.Run(ctx, input)).To(Succeed())
+		g.Expect(written).To(Equal([]string{notebookName}))
+	})
+
 	t.Run("should handle write errors gracefully", func(t *testing.T) {
 		io := iostreams.NewIOStreams(nil, nil, nil)
 		var writeCalls int
@@ -0,0 +1,117 @@
+package backup
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+
+	"filippo.io/age"
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// BackupResource is a resource read back from the backup layout.
+type BackupResource struct {
+	// GVR is the resource type, from the file name and the apiVersion of the object.
+	GVR schema.GroupVersionResource
+
+	// Object is the backed up resource.
+	Object *unstructured.Unstructured
+
+	// Path is the slash-separated path of the file within the backup.
+	Path string
+}
+
+// ReadResources reads the resources written by WriteResourceToFile and
+// WriteEncryptedResourceToFile from the backup directory or archive at from, sorted by
+// namespace, resource type and name. Encrypted files are decrypted with identities.
+// Files that are not in the layout $namespace/$GVR-$name.yaml[.age] are rejected.
+func ReadResources(from string, identities ...age.Identity) ([]BackupResource, error) {
+	var result []BackupResource
+
+	err := walkBackup(from, func(name string, rd io.Reader) error {
+		if !strings.HasSuffix(name, ".yaml") && !strings.HasSuffix(name, ".yaml"+encryptedSuffix) {
+			return nil
+		}
+
+		res, err := readResourceFile(name, rd, identities)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", name, err)
+		}
+
+		result = append(result, res)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading backup: %w", err)
+	}
+
+	slices.SortFunc(result, func(a, b BackupResource) int {
+		if c := strings.Compare(a.Object.GetNamespace(), b.Object.GetNamespace()); c != 0 {
+			return c
+		}
+
+		if c := strings.Compare(a.GVR.GroupResource().String(), b.GVR.GroupResource().String()); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.Object.GetName(), b.Object.GetName())
+	})
+
+	return result, nil
+}
+
+// readResourceFile reads a single resource file of the backup layout, where name is the
+// slash-separated path of the file within the backup. The resource type is the file name
+// without the "-$name.yaml" suffix, as written by WriteResourceToFile.
+func readResourceFile(name string, rd io.Reader, identities []age.Identity) (BackupResource, error) {
+	data, err := io.ReadAll(rd)
+	if err != nil {
+		return BackupResource{}, fmt.Errorf("reading file: %w", err)
+	}
+
+	if strings.HasSuffix(name, encryptedSuffix) {
+		if data, err = decrypt(data, identities); err != nil {
+			return BackupResource{}, err
+		}
+	}
+
+	obj := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal(data, &obj.Object); err != nil {
+		return BackupResource{}, fmt.Errorf("unmarshaling YAML: %w", err)
+	}
+
+	if obj.GetAPIVersion() == "" || obj.GetKind() == "" || obj.GetName() == "" {
+		return BackupResource{}, errors.New("not a Kubernetes object: apiVersion, kind and metadata.name are required")
+	}
+
+	namespace, filename := path.Split(strings.TrimSuffix(name, encryptedSuffix))
+	namespace = path.Clean(namespace)
+
+	expectedNamespace := obj.GetNamespace()
+	if expectedNamespace == "" {
+		expectedNamespace = clusterScopedDir
+	}
+
+	gvrStr, ok := strings.CutSuffix(filename, "-"+obj.GetName()+".yaml")
+	if namespace != expectedNamespace || !ok || gvrStr == "" {
+		return BackupResource{}, fmt.Errorf("file is not in the backup layout $namespace/$GVR-$name.yaml of %s %s/%s",
+			obj.GetKind(), expectedNamespace, obj.GetName())
+	}
+
+	gvr := parseGVRString(gvrStr)
+	gvr.Version = obj.GroupVersionKind().Version
+
+	if gvr.Group != obj.GroupVersionKind().Group {
+		return BackupResource{}, fmt.Errorf("resource group %q of the file name does not match apiVersion %s",
+			gvr.Group, obj.GetAPIVersion())
+	}
+
+	return BackupResource{GVR: gvr, Object: obj, Path: name}, nil
+}
@@ -4,44 +4,62 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
+	"filippo.io/age"
 	"sigs.k8s.io/yaml"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
+// clusterScopedDir is the directory of cluster-scoped resources in the backup layout.
+const clusterScopedDir = "cluster-scoped"
+
 // WriteResourceToFile writes a resource to $outputDir/$namespace/$GVR-$name.yaml.
 func WriteResourceToFile(
 	outputDir string,
 	gvr schema.GroupVersionResource,
 	obj *unstructured.Unstructured,
 ) error {
-	namespace := obj.GetNamespace()
-	if namespace == "" {
-		namespace = "cluster-scoped"
-	}
-	name := obj.GetName()
-
-	nsDir := filepath.Join(outputDir, namespace)
-	if err := os.MkdirAll(nsDir, dirPermissions); err != nil {
-		return fmt.Errorf("creating namespace directory: %w", err)
+	data, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return fmt.Errorf("marshaling to YAML: %w", err)
 	}
 
-	gvrStr := gvr.Resource
-	if gvr.Group != "" {
-		gvrStr = gvr.Resource + "." + gvr.Group
-	}
-	filename := fmt.Sprintf("%s-%s.yaml", gvrStr, name)
-	filePath := filepath.Join(nsDir, filename)
+	return writeFile(outputDir, resourcePath(gvr, obj), data)
+}
 
+// WriteEncryptedResourceToFile writes a resource encrypted with age for recipients to
+// $outputDir/$namespace/$GVR-$name.yaml.age.
+func WriteEncryptedResourceToFile(
+	outputDir string,
+	gvr schema.GroupVersionResource,
+	obj *unstructured.Unstructured,
+	recipients []age.Recipient,
+) error {
 	data, err := yaml.Marshal(obj.Object)
 	if err != nil {
 		return fmt.Errorf("marshaling to YAML: %w", err)
 	}
 
+	encrypted, err := encrypt(data, recipients)
+	if err != nil {
+		return err
+	}
+
+	return writeFile(outputDir, resourcePath(gvr, obj)+encryptedSuffix, encrypted)
+}
+
+// writeFile writes data to the slash-separated path rel below outputDir.
+func writeFile(outputDir string, rel string, data []byte) error {
+	filePath := filepath.Join(outputDir, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(filePath), dirPermissions); err != nil {
+		return fmt.Errorf("creating namespace directory: %w", err)
+	}
+
 	if err := os.WriteFile(filePath, data, filePermissions); err != nil {
 		return fmt.Errorf("writing file: %w", err)
 	}
@@ -49,6 +67,23 @@ func WriteResourceToFile(
 	return nil
 }
 
+// resourcePath returns the slash-separated location of a resource relative to the
+// backup root: $namespace/$GVR-$name.yaml, with cluster-scoped resources under
+// "cluster-scoped".
+func resourcePath(gvr schema.GroupVersionResource, obj *unstructured.Unstructured) string {
+	namespace := obj.GetNamespace()
+	if namespace == "" {
+		namespace = clusterScopedDir
+	}
+
+	gvrStr := gvr.Resource
+	if gvr.Group != "" {
+		gvrStr = gvr.Resource + "." + gvr.Group
+	}
+
+	return path.Join(namespace, fmt.Sprintf("%s-%s.yaml", gvrStr, obj.GetName()))
+}
+
 // WriteResourceToStdout writes a resource to stdout as YAML with --- separator.
 func WriteResourceToStdout(
 	out io.Writer,
@@ -0,0 +1,466 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"filippo.io/age"
+	"github.com/spf13/pflag"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+)
+
+// ConflictPolicy selects how restore handles objects that already exist in the cluster.
+type ConflictPolicy string
+
+const (
+	// ConflictSkip leaves existing objects untouched.
+	ConflictSkip ConflictPolicy = "skip"
+
+	// ConflictOverwrite applies the backed up objects over existing ones, taking
+	// ownership of conflicting fields.
+	ConflictOverwrite ConflictPolicy = "overwrite"
+
+	// ConflictFail restores nothing when any of the objects already exists.
+	ConflictFail ConflictPolicy = "fail"
+)
+
+// RestoreAction is the outcome of restoring one object.
+type RestoreAction string
+
+const (
+	RestoreCreated  RestoreAction = "created"
+	RestoreUpdated  RestoreAction = "updated"
+	RestoreSkipped  RestoreAction = "skipped"
+	RestoreConflict RestoreAction = "conflict"
+	RestoreFailed   RestoreAction = "failed"
+)
+
+const (
+	restoreOutputTable = "table"
+	restoreOutputJSON  = "json"
+)
+
+// restoreOrder is the order in which resource types are restored, so that the
+// dependencies of a workload exist before the workload. Other types come last.
+//
+//nolint:gochecknoglobals // Configuration constant for restore ordering.
+var restoreOrder = []schema.GroupResource{
+	{Resource: "secrets"},
+	{Resource: "configmaps"},
+	{Resource: "persistentvolumeclaims"},
+	{Resource: "serviceaccounts"},
+	{Group: "serving.kserve.io", Resource: "servingruntimes"},
+}
+
+// restoreStripFields are stripped from every object before it is applied. Owner
+// references carry the UIDs of the source objects, so the garbage collector would
+// delete restored dependencies right away, since their owners get new UIDs.
+//
+//nolint:gochecknoglobals // Configuration constant for restore field stripping.
+var restoreStripFields = append(slices.Clone(DefaultStripFields), ".metadata.ownerReferences")
+
+// restorePVCStripFields are stripped from PersistentVolumeClaims in addition to
+// restoreStripFields. They bind the claim to a volume, or a node, of the source
+// cluster, so the claim would stay Pending instead of being provisioned anew.
+//
+//nolint:gochecknoglobals // Configuration constant for restore field stripping.
+var restorePVCStripFields = append(slices.Clone(restoreStripFields),
+	".spec.volumeName",
+	`.metadata.annotations["pv.kubernetes.io/bind-completed"]`,
+	`.metadata.annotations["pv.kubernetes.io/bound-by-controller"]`,
+	`.metadata.annotations["volume.kubernetes.io/selected-node"]`,
+)
+
+//nolint:gochecknoglobals
+var restoreTableHeaders = []string{"NAMESPACE", "RESOURCE", "NAME", "ACTION", "MESSAGE"}
+
+// RestoreResult is the outcome of restoring one object.
+type RestoreResult struct {
+	Resource  string        `json:"resource"`
+	Namespace string        `json:"namespace,omitempty"`
+	Name      string        `json:"name"`
+	Action    RestoreAction `json:"action"`
+	Message   string        `json:"message,omitempty"`
+}
+
+// RestoreReport is the JSON output of restore.
+type RestoreReport struct {
+	DryRun  bool            `json:"dryRun"`
+	Results []RestoreResult `json:"results"`
+}
+
+// RestoreCommand re-applies the resources of a backup directory with server-side apply.
+type RestoreCommand struct {
+	*SharedOptions
+
+	// From is the backup directory or archive written by the backup command.
+	From string
+
+	// DryRun applies the objects with server-side dry run, so they are validated by the
+	// API server without being persisted.
+	DryRun bool
+
+	// NamespaceMap restores the objects of a backed up namespace (key) into another
+	// namespace (value).
+	NamespaceMap map[string]string
+
+	// OnConflict selects how objects that already exist are handled.
+	OnConflict ConflictPolicy
+
+	// OutputFormat is the format of the per-object summary: table or json.
+	OutputFormat string
+
+	// Identities are age identity files used to decrypt the Secrets of a backup
+	// written with --secrets=encrypt.
+	Identities []string
+
+	// PassphraseFile holds the passphrase the Secrets of the backup were encrypted with.
+	PassphraseFile string
+
+	identities []age.Identity
+}
+
+// NewRestoreCommand creates a new RestoreCommand with defaults.
+func NewRestoreCommand(streams genericiooptions.IOStreams) *RestoreCommand {
+	return &RestoreCommand{
+		SharedOptions: NewSharedOptions(streams),
+		OnConflict:    ConflictSkip,
+		OutputFormat:  restoreOutputTable,
+	}
+}
+
+// AddFlags adds flags to the command.
+func (c *RestoreCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.From, "from", "", "Backup directory or archive to restore (written by backup --output-dir or --archive)")
+	fs.BoolVar(&c.DryRun, "dry-run", false, "Validate the objects with server-side dry run without persisting them")
+	fs.StringToStringVar(&c.NamespaceMap, "namespace-map", nil, "Restore the objects of a namespace into another one (repeatable, e.g., --namespace-map old=new)")
+	fs.StringVar((*string)(&c.OnConflict), "on-conflict", string(ConflictSkip), "How to handle objects that already exist (skip|overwrite|fail)")
+	fs.StringVarP(&c.OutputFormat, "output", "o", restoreOutputTable, "Output format of the per-object summary (table|json)")
+	fs.StringArrayVar(&c.Identities, "identity", nil, "age identity file to decrypt encrypted Secrets with (repeatable)")
+	fs.StringVar(&c.PassphraseFile, "passphrase-file", "", "File holding the passphrase to decrypt encrypted Secrets with")
+	fs.BoolVarP(&c.Verbose, "verbose", "v", false, "Enable verbose output")
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, "Timeout for restore operation")
+
+	// Throttling settings
+	fs.Float32Var(&c.QPS, "qps", c.QPS, "Kubernetes API QPS limit (queries per second)")
+	fs.IntVar(&c.Burst, "burst", c.Burst, "Kubernetes API burst capacity")
+}
+
+// Complete populates the client and loads the decryption identities.
+func (c *RestoreCommand) Complete() error {
+	if err := c.SharedOptions.Complete(); err != nil {
+		return err
+	}
+
+	identities, err := ParseIdentities(c.Identities, c.PassphraseFile)
+	if err != nil {
+		return err
+	}
+
+	c.identities = identities
+
+	return nil
+}
+
+// Validate checks that all options are valid.
+func (c *RestoreCommand) Validate() error {
+	if err := c.SharedOptions.Validate(); err != nil {
+		return err
+	}
+
+	if c.From == "" {
+		return errors.New("--from is required")
+	}
+
+	if !slices.Contains([]ConflictPolicy{ConflictSkip, ConflictOverwrite, ConflictFail}, c.OnConflict) {
+		return fmt.Errorf("invalid --on-conflict: %s (must be one of: skip, overwrite, fail)", c.OnConflict)
+	}
+
+	if !slices.Contains([]string{restoreOutputTable, restoreOutputJSON}, c.OutputFormat) {
+		return fmt.Errorf("unsupported output format for restore: %s (must be one of: table, json)", c.OutputFormat)
+	}
+
+	for from, to := range c.NamespaceMap {
+		if from == "" || to == "" {
+			return fmt.Errorf("invalid --namespace-map %s=%s: both namespaces are required", from, to)
+		}
+	}
+
+	return nil
+}
+
+// Run restores the backup and prints the outcome of every object. It returns an error
+// when any object could not be restored.
+func (c *RestoreCommand) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	backed, err := ReadResources(c.From, c.identities...)
+	if err != nil {
+		return err
+	}
+
+	if len(backed) == 0 {
+		return fmt.Errorf("no resources found in %s", c.From)
+	}
+
+	if err := c.prepare(backed); err != nil {
+		return err
+	}
+
+	if err := c.checkNamespaces(ctx, backed); err != nil {
+		return err
+	}
+
+	if c.Verbose {
+		action := "Restoring"
+		if c.DryRun {
+			action = "Dry-run: restoring"
+		}
+
+		c.IO.Errorf("%s %d objects from %s...", action, len(backed), c.From)
+	}
+
+	report := &RestoreReport{DryRun: c.DryRun, Results: c.restore(ctx, backed)}
+
+	if err := c.output(report); err != nil {
+		return err
+	}
+
+	failed := 0
+
+	for _, r := range report.Results {
+		if r.Action == RestoreFailed || r.Action == RestoreConflict {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d objects could not be restored", failed, len(report.Results))
+	}
+
+	return nil
+}
+
+// prepare strips cluster-specific fields, remaps namespaces and sorts the resources into
+// restore order: Secrets, ConfigMaps and PVCs before the workloads that reference them.
+func (c *RestoreCommand) prepare(backed []BackupResource) error {
+	for i := range backed {
+		fields := restoreStripFields
+		if backed[i].GVR.GroupResource() == resources.PersistentVolumeClaim.GVR().GroupResource() {
+			fields = restorePVCStripFields
+		}
+
+		obj, err := kube.StripFields(backed[i].Object, fields)
+		if err != nil {
+			return fmt.Errorf("stripping fields of %s: %w", backed[i].Path, err)
+		}
+
+		if to, ok := c.NamespaceMap[obj.GetNamespace()]; ok && obj.GetNamespace() != "" {
+			obj.SetNamespace(to)
+		}
+
+		backed[i].Object = obj
+	}
+
+	slices.SortStableFunc(backed, func(a, b BackupResource) int {
+		return restoreTier(a.GVR) - restoreTier(b.GVR)
+	})
+
+	return nil
+}
+
+// checkNamespaces fails when any namespace the resources are restored into does not
+// exist. Restore does not create namespaces: data science projects carry labels and
+// annotations that a bare namespace would lack.
+func (c *RestoreCommand) checkNamespaces(ctx context.Context, backed []BackupResource) error {
+	var missing []string
+
+	checked := make(map[string]bool)
+
+	for _, res := range backed {
+		ns := res.Object.GetNamespace()
+		if ns == "" || checked[ns] {
+			continue
+		}
+
+		checked[ns] = true
+
+		_, err := c.Client.Dynamic().Resource(resources.Namespace.GVR()).Get(ctx, ns, metav1.GetOptions{})
+
+		switch {
+		case apierrors.IsNotFound(err):
+			missing = append(missing, ns)
+		case err != nil:
+			return fmt.Errorf("getting namespace %s: %w", ns, err)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("namespaces do not exist: %s (create them before restoring)", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// restoreTier returns the position of a resource type in restoreOrder.
+func restoreTier(gvr schema.GroupVersionResource) int {
+	if i := slices.Index(restoreOrder, gvr.GroupResource()); i >= 0 {
+		return i
+	}
+
+	return len(restoreOrder)
+}
+
+// restore applies the resources in order. With ConflictFail, nothing is applied when any
+// of the objects already exists.
+func (c *RestoreCommand) restore(ctx context.Context, backed []BackupResource) []RestoreResult {
+	results := make([]RestoreResult, len(backed))
+	exists := make([]bool, len(backed))
+	conflicts := false
+
+	for i, res := range backed {
+		results[i] = RestoreResult{
+			Resource:  res.GVR.GroupResource().String(),
+			Namespace: res.Object.GetNamespace(),
+			Name:      res.Object.GetName(),
+		}
+
+		// Secret stubs would replace existing values with empty ones.
+		if isRedacted(res.Object) {
+			results[i].Action = RestoreSkipped
+			results[i].Message = "redacted in backup"
+
+			continue
+		}
+
+		_, err := c.Client.Dynamic().Resource(res.GVR).Namespace(res.Object.GetNamespace()).
+			Get(ctx, res.Object.GetName(), metav1.GetOptions{})
+
+		switch {
+		case err == nil:
+			exists[i] = true
+			conflicts = true
+		case !apierrors.IsNotFound(err):
+			results[i].Action = RestoreFailed
+			results[i].Message = err.Error()
+		}
+	}
+
+	for i, res := range backed {
+		if results[i].Action != "" {
+			continue
+		}
+
+		switch {
+		case c.OnConflict == ConflictFail && exists[i]:
+			results[i].Action = RestoreConflict
+			results[i].Message = "already exists"
+		case c.OnConflict == ConflictFail && conflicts:
+			results[i].Action = RestoreSkipped
+			results[i].Message = "not restored: other objects already exist"
+		case c.OnConflict == ConflictSkip && exists[i]:
+			results[i].Action = RestoreSkipped
+			results[i].Message = "already exists"
+		default:
+			c.apply(ctx, res, exists[i], &results[i])
+		}
+	}
+
+	return results
+}
+
+// apply applies one resource with server-side apply and records the outcome in result.
+func (c *RestoreCommand) apply(ctx context.Context, res BackupResource, exists bool, result *RestoreResult) {
+	opts := metav1.ApplyOptions{
+		FieldManager: client.FieldManager,
+		Force:        c.OnConflict == ConflictOverwrite,
+	}
+
+	if c.DryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+
+	_, err := c.Client.Dynamic().Resource(res.GVR).Namespace(res.Object.GetNamespace()).
+		Apply(ctx, res.Object.GetName(), res.Object, opts)
+	if err != nil {
+		result.Action = RestoreFailed
+		result.Message = err.Error()
+
+		return
+	}
+
+	result.Action = RestoreCreated
+	if exists {
+		result.Action = RestoreUpdated
+	}
+
+	if c.Verbose {
+		c.IO.Errorf("  %s %s %s/%s", result.Action, result.Resource, result.Namespace, result.Name)
+	}
+}
+
+// output writes the report in the selected output format.
+func (c *RestoreCommand) output(report *RestoreReport) error {
+	if c.OutputFormat == restoreOutputJSON {
+		renderer := printerjson.NewRenderer[*RestoreReport](
+			printerjson.WithWriter[*RestoreReport](c.IO.Out()),
+		)
+
+		if err := renderer.Render(report); err != nil {
+			return fmt.Errorf("rendering JSON output: %w", err)
+		}
+
+		return nil
+	}
+
+	return OutputRestoreTable(c.IO.Out(), report)
+}
+
+// OutputRestoreTable prints one row per object followed by the number of objects per action.
+func OutputRestoreTable(out io.Writer, report *RestoreReport) error {
+	renderer := table.NewRenderer[RestoreResult](
+		table.WithWriter[RestoreResult](out),
+		table.WithHeaders[RestoreResult](restoreTableHeaders...),
+		table.WithTableOptions[RestoreResult](table.DefaultTableOptions...),
+	)
+
+	counts := make(map[RestoreAction]int)
+
+	for _, r := range report.Results {
+		counts[r.Action]++
+
+		if err := renderer.Append(r); err != nil {
+			return fmt.Errorf("appending table row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering table: %w", err)
+	}
+
+	suffix := ""
+	if report.DryRun {
+		suffix = " (dry run)"
+	}
+
+	_, _ = fmt.Fprintf(out, "\n%d objects: %d created, %d updated, %d skipped, %d conflicts, %d failed%s\n",
+		len(report.Results), counts[RestoreCreated], counts[RestoreUpdated], counts[RestoreSkipped],
+		counts[RestoreConflict], counts[RestoreFailed], suffix)
+
+	return nil
+}
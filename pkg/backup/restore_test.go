@@ -0,0 +1,359 @@
+package backup_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+const restoreNamespace = "team-a"
+
+//nolint:gochecknoglobals
+var notebookGVR = schema.GroupVersionResource{Group: "kubeflow.org", Version: "v1", Resource: "notebooks"}
+
+func newObject(apiVersion string, kind string, namespace string, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+
+	return obj
+}
+
+// writeBackup writes a notebook with its Secret, ConfigMap and PVC in the backup layout.
+func writeBackup(t *testing.T) string {
+	t.Helper()
+
+	g := NewWithT(t)
+	dir := t.TempDir()
+
+	notebook := newObject("kubeflow.org/v1", "Notebook", restoreNamespace, "wb")
+	notebook.SetResourceVersion("42")
+	notebook.SetUID("uid")
+
+	for _, w := range []struct {
+		gvr schema.GroupVersionResource
+		obj *unstructured.Unstructured
+	}{
+		{notebookGVR, notebook},
+		{resources.Secret.GVR(), newObject("v1", "Secret", restoreNamespace, "wb-secret")},
+		{resources.ConfigMap.GVR(), newObject("v1", "ConfigMap", restoreNamespace, "wb-config")},
+		{resources.PersistentVolumeClaim.GVR(), newObject("v1", "PersistentVolumeClaim", restoreNamespace, "wb-data")},
+	} {
+		g.Expect(backup.WriteResourceToFile(dir, w.gvr, w.obj)).To(Succeed())
+	}
+
+	return dir
+}
+
+// newRestoreClient returns a client whose fake dynamic client implements server-side
+// apply by creating or replacing the applied object, unless it is a dry run. The
+// team-a and team-b namespaces exist.
+func newRestoreClient(objects ...runtime.Object) (client.Client, *dynamicfake.FakeDynamicClient) {
+	objects = append(objects,
+		newObject("v1", "Namespace", "", restoreNamespace),
+		newObject("v1", "Namespace", "", "team-b"),
+	)
+
+	scheme := runtime.NewScheme()
+	dyn := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, map[schema.GroupVersionResource]string{
+		notebookGVR:                           "NotebookList",
+		resources.Namespace.GVR():             resources.Namespace.ListKind(),
+		resources.Secret.GVR():                resources.Secret.ListKind(),
+		resources.ConfigMap.GVR():             resources.ConfigMap.ListKind(),
+		resources.PersistentVolumeClaim.GVR(): resources.PersistentVolumeClaim.ListKind(),
+	}, objects...)
+
+	dyn.PrependReactor("patch", "*", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		patch, ok := action.(clienttesting.PatchActionImpl)
+		if !ok {
+			return false, nil, nil
+		}
+
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal(patch.GetPatch(), &obj.Object); err != nil {
+			return true, nil, err
+		}
+
+		if len(patch.GetPatchOptions().DryRun) > 0 {
+			return true, obj, nil
+		}
+
+		tracker := dyn.Tracker()
+
+		_, err := tracker.Get(patch.GetResource(), patch.GetNamespace(), patch.GetName())
+
+		switch {
+		case apierrors.IsNotFound(err):
+			err = tracker.Create(patch.GetResource(), obj, patch.GetNamespace())
+		case err == nil:
+			err = tracker.Update(patch.GetResource(), obj, patch.GetNamespace())
+		}
+
+		return true, obj, err
+	})
+
+	return client.NewForTesting(client.TestClientConfig{Dynamic: dyn}), dyn
+}
+
+func newRestoreCommand(t *testing.T, from string, cl client.Client) (*backup.RestoreCommand, *bytes.Buffer) {
+	t.Helper()
+
+	var out bytes.Buffer
+
+	command := backup.NewRestoreCommand(genericiooptions.IOStreams{
+		In:     &bytes.Buffer{},
+		Out:    &out,
+		ErrOut: &bytes.Buffer{},
+	})
+	command.From = from
+	command.Client = cl
+	command.OutputFormat = "json"
+
+	return command, &out
+}
+
+func decodeReport(t *testing.T, out *bytes.Buffer) backup.RestoreReport {
+	t.Helper()
+
+	var report backup.RestoreReport
+	NewWithT(t).Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+
+	return report
+}
+
+func TestReadResources(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := writeBackup(t)
+
+	backed, err := backup.ReadResources(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(backed).To(HaveLen(4))
+
+	g.Expect(backed[0].GVR).To(Equal(resources.ConfigMap.GVR()))
+	g.Expect(backed[1].GVR).To(Equal(notebookGVR))
+	g.Expect(backed[1].Object.GetName()).To(Equal("wb"))
+
+	t.Run("should reject files outside the backup layout", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := writeBackup(t)
+		g.Expect(os.Rename(
+			filepath.Join(dir, restoreNamespace, "notebooks.kubeflow.org-wb.yaml"),
+			filepath.Join(dir, restoreNamespace, "notebook.yaml"),
+		)).To(Succeed())
+
+		_, err := backup.ReadResources(dir)
+		g.Expect(err).To(MatchError(ContainSubstring("not in the backup layout")))
+	})
+}
+
+func TestRestoreCommand_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	command := backup.NewRestoreCommand(genericiooptions.NewTestIOStreamsDiscard())
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--from is required")))
+
+	command.From = t.TempDir()
+	g.Expect(command.Validate()).To(Succeed())
+
+	command.OnConflict = "merge"
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("invalid --on-conflict")))
+
+	command.OnConflict = backup.ConflictFail
+	command.NamespaceMap = map[string]string{"team-a": ""}
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("invalid --namespace-map")))
+}
+
+func TestRestoreCommand_Run(t *testing.T) {
+	t.Run("should apply dependencies before workloads", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cl, dyn := newRestoreClient()
+		command, out := newRestoreCommand(t, writeBackup(t), cl)
+
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		report := decodeReport(t, out)
+		g.Expect(report.Results).To(HaveExactElements(
+			And(HaveField("Resource", "secrets"), HaveField("Action", backup.RestoreCreated)),
+			And(HaveField("Resource", "configmaps"), HaveField("Action", backup.RestoreCreated)),
+			And(HaveField("Resource", "persistentvolumeclaims"), HaveField("Action", backup.RestoreCreated)),
+			And(HaveField("Resource", "notebooks.kubeflow.org"), HaveField("Action", backup.RestoreCreated)),
+		))
+
+		notebook, err := dyn.Resource(notebookGVR).Namespace(restoreNamespace).Get(t.Context(), "wb", metav1.GetOptions{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(notebook.GetUID()).To(BeEmpty())
+
+		// Every object was applied with server-side apply.
+		for _, action := range dyn.Actions() {
+			if patch, ok := action.(clienttesting.PatchActionImpl); ok {
+				g.Expect(patch.GetPatchOptions().FieldManager).To(Equal(client.FieldManager))
+			}
+		}
+	})
+
+	t.Run("should drop owner references", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+
+		secret := newObject("v1", "Secret", restoreNamespace, "wb-secret")
+		secret.SetOwnerReferences([]metav1.OwnerReference{{
+			APIVersion: "kubeflow.org/v1",
+			Kind:       "Notebook",
+			Name:       "wb",
+			UID:        "source-uid",
+		}})
+		g.Expect(backup.WriteResourceToFile(dir, resources.Secret.GVR(), secret)).To(Succeed())
+
+		cl, dyn := newRestoreClient()
+		command, _ := newRestoreCommand(t, dir, cl)
+
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		restored, err := dyn.Resource(resources.Secret.GVR()).Namespace(restoreNamespace).Get(t.Context(), "wb-secret", metav1.GetOptions{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(restored.GetOwnerReferences()).To(BeEmpty())
+	})
+
+	t.Run("should unbind persistent volume claims", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+
+		pvc := newObject("v1", "PersistentVolumeClaim", restoreNamespace, "wb-data")
+		pvc.SetAnnotations(map[string]string{
+			"pv.kubernetes.io/bind-completed":      "yes",
+			"pv.kubernetes.io/bound-by-controller": "yes",
+			"volume.kubernetes.io/selected-node":   "worker-1",
+			"opendatahub.io/managed":               "true",
+		})
+		pvc.Object["spec"] = map[string]any{
+			"volumeName":  "pvc-source-volume",
+			"accessModes": []any{"ReadWriteOnce"},
+		}
+		g.Expect(backup.WriteResourceToFile(dir, resources.PersistentVolumeClaim.GVR(), pvc)).To(Succeed())
+
+		cl, dyn := newRestoreClient()
+		command, _ := newRestoreCommand(t, dir, cl)
+		command.NamespaceMap = map[string]string{restoreNamespace: "team-b"}
+
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		restored, err := dyn.Resource(resources.PersistentVolumeClaim.GVR()).Namespace("team-b").Get(t.Context(), "wb-data", metav1.GetOptions{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(restored.GetAnnotations()).To(Equal(map[string]string{"opendatahub.io/managed": "true"}))
+		g.Expect(restored.Object).To(HaveKeyWithValue("spec", Equal(map[string]any{"accessModes": []any{"ReadWriteOnce"}})))
+	})
+
+	t.Run("should fail early when a target namespace does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cl, dyn := newRestoreClient()
+		command, out := newRestoreCommand(t, writeBackup(t), cl)
+		command.NamespaceMap = map[string]string{restoreNamespace: "team-c"}
+
+		g.Expect(command.Run(t.Context())).To(MatchError("namespaces do not exist: team-c (create them before restoring)"))
+		g.Expect(out.String()).To(BeEmpty())
+
+		for _, action := range dyn.Actions() {
+			g.Expect(action.GetVerb()).ToNot(Equal("patch"))
+		}
+	})
+
+	t.Run("should not persist objects in dry run", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cl, dyn := newRestoreClient()
+		command, out := newRestoreCommand(t, writeBackup(t), cl)
+		command.DryRun = true
+
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(decodeReport(t, out).DryRun).To(BeTrue())
+
+		_, err := dyn.Resource(notebookGVR).Namespace(restoreNamespace).Get(t.Context(), "wb", metav1.GetOptions{})
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	t.Run("should remap namespaces", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cl, dyn := newRestoreClient()
+		command, out := newRestoreCommand(t, writeBackup(t), cl)
+		command.NamespaceMap = map[string]string{restoreNamespace: "team-b"}
+
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(decodeReport(t, out).Results).To(HaveEach(HaveField("Namespace", "team-b")))
+
+		_, err := dyn.Resource(notebookGVR).Namespace("team-b").Get(t.Context(), "wb", metav1.GetOptions{})
+		g.Expect(err).ToNot(HaveOccurred())
+	})
+
+	t.Run("should apply the conflict policy to existing objects", func(t *testing.T) {
+		existing := newObject("v1", "ConfigMap", restoreNamespace, "wb-config")
+
+		for _, tc := range []struct {
+			policy    backup.ConflictPolicy
+			configMap backup.RestoreAction
+			notebook  backup.RestoreAction
+			failed    bool
+		}{
+			{backup.ConflictSkip, backup.RestoreSkipped, backup.RestoreCreated, false},
+			{backup.ConflictOverwrite, backup.RestoreUpdated, backup.RestoreCreated, false},
+			{backup.ConflictFail, backup.RestoreConflict, backup.RestoreSkipped, true},
+		} {
+			t.Run(string(tc.policy), func(t *testing.T) {
+				g := NewWithT(t)
+
+				cl, _ := newRestoreClient(existing.DeepCopy())
+				command, out := newRestoreCommand(t, writeBackup(t), cl)
+				command.OnConflict = tc.policy
+
+				err := command.Run(t.Context())
+				if tc.failed {
+					g.Expect(err).To(MatchError("1 of 4 objects could not be restored"))
+				} else {
+					g.Expect(err).ToNot(HaveOccurred())
+				}
+
+				g.Expect(decodeReport(t, out).Results).To(ContainElements(
+					And(HaveField("Resource", "configmaps"), HaveField("Action", tc.configMap)),
+					And(HaveField("Resource", "notebooks.kubeflow.org"), HaveField("Action", tc.notebook)),
+				))
+			})
+		}
+	})
+
+	t.Run("should print a table summary", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cl, _ := newRestoreClient()
+		command, out := newRestoreCommand(t, writeBackup(t), cl)
+		command.OutputFormat = "table"
+		command.DryRun = true
+
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring("4 objects: 4 created, 0 updated, 0 skipped, 0 conflicts, 0 failed (dry run)"))
+	})
+}
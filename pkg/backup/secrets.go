@@ -0,0 +1,192 @@
+package backup
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"filippo.io/age"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+// SecretPolicy selects how backup writes Secret objects.
+type SecretPolicy string
+
+const (
+	// SecretsPlain writes Secrets as they are, with their values base64-encoded.
+	SecretsPlain SecretPolicy = "plain"
+
+	// SecretsRedact writes Secrets as stubs that keep the key names and drop the values.
+	SecretsRedact SecretPolicy = "redact"
+
+	// SecretsEncrypt writes every Secret encrypted with age to $GVR-$name.yaml.age.
+	SecretsEncrypt SecretPolicy = "encrypt"
+)
+
+// AnnotationRedacted marks a Secret written by the redact policy. Restore skips such
+// Secrets, so that existing values are never replaced by the stubs.
+const AnnotationRedacted = "backup.opendatahub.io/redacted"
+
+// encryptedSuffix is appended to the file name of encrypted resources.
+const encryptedSuffix = ".age"
+
+// isSecret reports whether gvr is the core Secret resource.
+func isSecret(gvr schema.GroupVersionResource) bool {
+	return gvr.GroupResource() == resources.Secret.GVR().GroupResource()
+}
+
+// redactExpression empties every data and stringData value of a Secret and annotates it
+// with AnnotationRedacted.
+const redactExpression = `reduce ("data", "stringData") as $field (.;
+	if .[$field] | type == "object" then .[$field] |= map_values("") else . end)
+	| .metadata.annotations[%q] = "true"`
+
+// RedactSecret returns a copy of a Secret whose data and stringData values are empty,
+// annotated with AnnotationRedacted.
+func RedactSecret(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	redacted := obj.DeepCopy()
+
+	if err := jq.Transform(redacted, redactExpression, AnnotationRedacted); err != nil {
+		return nil, fmt.Errorf("redacting Secret: %w", err)
+	}
+
+	return redacted, nil
+}
+
+// isRedacted reports whether obj is a Secret stub written by the redact policy.
+func isRedacted(obj *unstructured.Unstructured) bool {
+	return obj.GetAnnotations()[AnnotationRedacted] == "true"
+}
+
+// ParseRecipients returns the age recipients for the given public keys, or the
+// passphrase-derived recipient for the passphrase stored in passphraseFile.
+func ParseRecipients(publicKeys []string, passphraseFile string) ([]age.Recipient, error) {
+	if passphraseFile != "" {
+		passphrase, err := readPassphrase(passphraseFile)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := age.NewScryptRecipient(passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("creating passphrase recipient: %w", err)
+		}
+
+		return []age.Recipient{r}, nil
+	}
+
+	result := make([]age.Recipient, 0, len(publicKeys))
+
+	for _, key := range publicKeys {
+		parsed, err := age.ParseRecipients(strings.NewReader(key))
+		if err != nil {
+			return nil, fmt.Errorf("parsing recipient %q: %w", key, err)
+		}
+
+		result = append(result, parsed...)
+	}
+
+	return result, nil
+}
+
+// ParseIdentities returns the age identities read from identityFiles, or the
+// passphrase-derived identity for the passphrase stored in passphraseFile.
+func ParseIdentities(identityFiles []string, passphraseFile string) ([]age.Identity, error) {
+	var result []age.Identity
+
+	for _, path := range identityFiles {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("opening identity file: %w", err)
+		}
+
+		parsed, err := age.ParseIdentities(f)
+		_ = f.Close()
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing identity file %s: %w", path, err)
+		}
+
+		result = append(result, parsed...)
+	}
+
+	if passphraseFile != "" {
+		passphrase, err := readPassphrase(passphraseFile)
+		if err != nil {
+			return nil, err
+		}
+
+		id, err := age.NewScryptIdentity(passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("creating passphrase identity: %w", err)
+		}
+
+		result = append(result, id)
+	}
+
+	return result, nil
+}
+
+// readPassphrase reads a passphrase from the first line of path.
+func readPassphrase(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading passphrase file: %w", err)
+	}
+
+	passphrase, _, _ := strings.Cut(string(data), "\n")
+	passphrase = strings.TrimSuffix(passphrase, "\r")
+
+	if passphrase == "" {
+		return "", fmt.Errorf("passphrase file %s is empty", path)
+	}
+
+	return passphrase, nil
+}
+
+// encrypt encrypts data for recipients.
+func encrypt(data []byte, recipients []age.Recipient) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w, err := age.Encrypt(&buf, recipients...)
+	if err != nil {
+		return nil, fmt.Errorf("encrypting: %w", err)
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return nil, fmt.Errorf("encrypting: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("encrypting: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// decrypt decrypts data encrypted by encrypt with one of identities.
+func decrypt(data []byte, identities []age.Identity) ([]byte, error) {
+	if len(identities) == 0 {
+		return nil, errors.New("file is encrypted: --identity or --passphrase-file is required")
+	}
+
+	rd, err := age.Decrypt(bytes.NewReader(data), identities...)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting: %w", err)
+	}
+
+	plain, err := io.ReadAll(rd)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting: %w", err)
+	}
+
+	return plain, nil
+}
@@ -0,0 +1,190 @@
+package backup_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"filippo.io/age"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/backup"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRedactSecret(t *testing.T) {
+	g := NewWithT(t)
+
+	secret := newObject("v1", "Secret", restoreNamespace, "wb-secret")
+	secret.Object["data"] = map[string]any{"token": secretToken}
+	secret.Object["stringData"] = map[string]any{"user": "admin"}
+
+	redacted, err := backup.RedactSecret(secret)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(redacted.Object["data"]).To(Equal(map[string]any{"token": ""}))
+	g.Expect(redacted.Object["stringData"]).To(Equal(map[string]any{"user": ""}))
+	g.Expect(redacted.GetAnnotations()).To(HaveKeyWithValue(backup.AnnotationRedacted, "true"))
+
+	// The original object is left untouched.
+	g.Expect(secret.Object["data"]).To(Equal(map[string]any{"token": secretToken}))
+
+	t.Run("should keep Secrets without values as they are", func(t *testing.T) {
+		g := NewWithT(t)
+
+		empty := newObject("v1", "Secret", restoreNamespace, "empty")
+		empty.SetAnnotations(map[string]string{"team": "a"})
+
+		redacted, err := backup.RedactSecret(empty)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(redacted.Object).ToNot(HaveKey("data"))
+		g.Expect(redacted.Object).ToNot(HaveKey("stringData"))
+		g.Expect(redacted.GetAnnotations()).To(Equal(map[string]string{"team": "a", backup.AnnotationRedacted: "true"}))
+	})
+}
+
+func TestCommand_ValidateSecrets(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		policy     backup.SecretPolicy
+		recipients []string
+		passphrase string
+		outputDir  string
+		err        string
+	}{
+		{name: "plain", policy: backup.SecretsPlain},
+		{name: "redact to stdout", policy: backup.SecretsRedact},
+		{name: "unknown policy", policy: "hide", err: "invalid --secrets"},
+		{name: "keys without encryption", policy: backup.SecretsRedact, recipients: []string{"age1x"}, err: "require --secrets=encrypt"},
+		{name: "encrypt without keys", policy: backup.SecretsEncrypt, outputDir: "/backup", err: "requires --recipient or --passphrase-file"},
+		{name: "recipients and passphrase", policy: backup.SecretsEncrypt, recipients: []string{"age1x"}, passphrase: "pass", outputDir: "/backup", err: "mutually exclusive"},
+		{name: "encrypt to stdout", policy: backup.SecretsEncrypt, recipients: []string{"age1x"}, err: "requires --output-dir or --archive"},
+		{name: "encrypt", policy: backup.SecretsEncrypt, recipients: []string{"age1x"}, outputDir: "/backup"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			command := backup.NewCommand(genericiooptions.NewTestIOStreamsDiscard())
+			command.Secrets = tc.policy
+			command.Recipients = tc.recipients
+			command.PassphraseFile = tc.passphrase
+			command.OutputDir = tc.outputDir
+
+			if tc.err == "" {
+				g.Expect(command.Validate()).To(Succeed())
+			} else {
+				g.Expect(command.Validate()).To(MatchError(ContainSubstring(tc.err)))
+			}
+		})
+	}
+}
+
+func TestCommand_RunRedactsSecrets(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	runBackup(t, dir, "", func(c *backup.Command) { c.Secrets = backup.SecretsRedact })
+
+	backed, err := backup.ReadResources(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(backed).To(ContainElement(And(
+		HaveField("GVR", resources.Secret.GVR()),
+		HaveField("Object.Object", HaveKeyWithValue("data", map[string]any{"token": ""})),
+	)))
+
+	t.Run("should not restore redacted Secrets", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cl, dyn := newRestoreClient()
+		command, out := newRestoreCommand(t, dir, cl)
+
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(decodeReport(t, out).Results).To(ContainElement(And(
+			HaveField("Resource", "secrets"),
+			HaveField("Action", backup.RestoreSkipped),
+			HaveField("Message", "redacted in backup"),
+		)))
+
+		_, err := dyn.Resource(resources.Secret.GVR()).Namespace(restoreNamespace).Get(t.Context(), "wb-secret", metav1.GetOptions{})
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func TestCommand_RunEncryptsSecrets(t *testing.T) {
+	g := NewWithT(t)
+
+	identity, err := age.GenerateX25519Identity()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	dir := t.TempDir()
+	runBackup(t, dir, "", func(c *backup.Command) {
+		c.Secrets = backup.SecretsEncrypt
+		c.Recipients = []string{identity.Recipient().String()}
+	})
+
+	encryptedPath := secretPath + ".age"
+
+	data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(encryptedPath)))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).ToNot(ContainSubstring(secretToken))
+	g.Expect(filepath.Join(dir, filepath.FromSlash(secretPath))).ToNot(BeAnExistingFile())
+
+	manifest := readManifest(t, dir)
+	g.Expect(manifest.Settings.Secrets).To(Equal(backup.SecretsEncrypt))
+	g.Expect(manifest.Files).To(ContainElements(
+		And(HaveField("Path", notebookPath), HaveField("Dependencies", []string{encryptedPath})),
+		HaveField("Path", encryptedPath),
+	))
+
+	result, err := backup.Verify(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Problems).To(BeEmpty())
+
+	_, err = backup.ReadResources(dir)
+	g.Expect(err).To(MatchError(ContainSubstring("--identity or --passphrase-file is required")))
+
+	t.Run("should decrypt Secrets on restore", func(t *testing.T) {
+		g := NewWithT(t)
+
+		identityFile := filepath.Join(t.TempDir(), "key.txt")
+		g.Expect(os.WriteFile(identityFile, []byte(identity.String()+"\n"), 0o600)).To(Succeed())
+
+		identities, err := backup.ParseIdentities([]string{identityFile}, "")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		backed, err := backup.ReadResources(dir, identities...)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(backed).To(ContainElement(And(
+			HaveField("GVR", resources.Secret.GVR()),
+			HaveField("Object.Object", HaveKeyWithValue("data", map[string]any{"token": secretToken})),
+		)))
+	})
+}
+
+func TestEncryptWithPassphrase(t *testing.T) {
+	g := NewWithT(t)
+
+	passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+	g.Expect(os.WriteFile(passphraseFile, []byte("correct horse battery staple\n"), 0o600)).To(Succeed())
+
+	recipients, err := backup.ParseRecipients(nil, passphraseFile)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	secret := newObject("v1", "Secret", restoreNamespace, "wb-secret")
+	secret.Object["data"] = map[string]any{"token": secretToken}
+
+	dir := t.TempDir()
+	g.Expect(backup.WriteEncryptedResourceToFile(dir, resources.Secret.GVR(), secret, recipients)).To(Succeed())
+
+	identities, err := backup.ParseIdentities(nil, passphraseFile)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	backed, err := backup.ReadResources(dir, identities...)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(backed).To(HaveLen(1))
+	g.Expect(backed[0].Object.Object).To(HaveKeyWithValue("data", map[string]any{"token": secretToken}))
+}
@@ -0,0 +1,192 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+// VerifyResult is the outcome of checking a backup against its manifest.
+type VerifyResult struct {
+	// Manifest is the manifest of the backup.
+	Manifest *Manifest
+
+	// Verified is the number of files whose SHA-256 matches the manifest.
+	Verified int
+
+	// Problems describe missing, modified and unexpected files, and dependency edges
+	// to files that are not in the manifest.
+	Problems []string
+}
+
+// Verify checks the backup directory or archive at path against its manifest.json.
+// It returns an error when the manifest cannot be read; integrity problems are
+// reported in the result.
+func Verify(path string) (*VerifyResult, error) {
+	var manifestData []byte
+
+	sums := make(map[string]string)
+
+	err := walkBackup(path, func(name string, rd io.Reader) error {
+		if name == ManifestFileName {
+			data, err := io.ReadAll(rd)
+			if err != nil {
+				return fmt.Errorf("reading manifest: %w", err)
+			}
+
+			manifestData = data
+
+			return nil
+		}
+
+		sum, err := hashReader(rd)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		sums[name] = sum
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading backup: %w", err)
+	}
+
+	if manifestData == nil {
+		return nil, fmt.Errorf("no %s found in %s", ManifestFileName, path)
+	}
+
+	manifest := &Manifest{}
+	if err := json.Unmarshal(manifestData, manifest); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", ManifestFileName, err)
+	}
+
+	result := &VerifyResult{Manifest: manifest}
+	listed := make(map[string]bool, len(manifest.Files))
+
+	for _, file := range manifest.Files {
+		listed[file.Path] = true
+	}
+
+	for _, file := range manifest.Files {
+		sum, ok := sums[file.Path]
+
+		switch {
+		case !ok:
+			result.Problems = append(result.Problems, "missing file: "+file.Path)
+		case sum != file.SHA256:
+			result.Problems = append(result.Problems, "checksum mismatch: "+file.Path)
+		default:
+			result.Verified++
+		}
+
+		for _, dep := range file.Dependencies {
+			if !listed[dep] {
+				result.Problems = append(result.Problems,
+					fmt.Sprintf("%s: dependency %s is not in the manifest", file.Path, dep))
+			}
+		}
+	}
+
+	var unexpected []string
+
+	for name := range sums {
+		if !listed[name] {
+			unexpected = append(unexpected, "unexpected file: "+name)
+		}
+	}
+
+	slices.Sort(unexpected)
+	result.Problems = append(result.Problems, unexpected...)
+
+	return result, nil
+}
+
+// VerifyCommand checks the integrity of a backup directory or archive against its manifest.
+type VerifyCommand struct {
+	IO iostreams.Interface
+
+	// Path is the backup directory or archive to verify.
+	Path string
+
+	Verbose bool
+}
+
+// NewVerifyCommand creates a new VerifyCommand.
+func NewVerifyCommand(streams genericiooptions.IOStreams) *VerifyCommand {
+	return &VerifyCommand{
+		IO: iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+	}
+}
+
+// AddFlags adds flags to the command.
+func (c *VerifyCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVarP(&c.Verbose, "verbose", "v", false, "Print the source cluster and settings recorded in the manifest")
+}
+
+// Complete populates derived values.
+func (c *VerifyCommand) Complete() error {
+	return nil
+}
+
+// Validate checks that all options are valid.
+func (c *VerifyCommand) Validate() error {
+	if c.Path == "" {
+		return errors.New("a backup directory or archive is required")
+	}
+
+	return nil
+}
+
+// Run verifies the backup and prints every problem found. It returns an error when
+// the backup does not match its manifest.
+func (c *VerifyCommand) Run(_ context.Context) error {
+	result, err := Verify(c.Path)
+	if err != nil {
+		return err
+	}
+
+	if c.Verbose {
+		m := result.Manifest
+
+		c.IO.Fprintf("Backup of %s taken %s with odh-cli %s",
+			m.APIServer, m.CreatedAt.Format(time.RFC3339), m.CLIVersion)
+		c.IO.Fprintf("  RHOAI version:     %s", valueOrUnknown(m.RHOAIVersion))
+		c.IO.Fprintf("  OpenShift version: %s", valueOrUnknown(m.OpenShiftVersion))
+		c.IO.Fprintf("  Includes:          %v", m.Settings.Includes)
+		c.IO.Fprintf("  Excludes:          %v", m.Settings.Excludes)
+		c.IO.Fprintf("  Strip fields:      %v", m.Settings.StripFields)
+		c.IO.Fprintf("")
+	}
+
+	for _, problem := range result.Problems {
+		c.IO.Fprintln(problem)
+	}
+
+	c.IO.Fprintf("%d of %d files verified, %d problems",
+		result.Verified, len(result.Manifest.Files), len(result.Problems))
+
+	if len(result.Problems) > 0 {
+		return fmt.Errorf("backup %s does not match its manifest", c.Path)
+	}
+
+	return nil
+}
+
+func valueOrUnknown(s string) string {
+	if s == "" {
+		return "unknown"
+	}
+
+	return s
+}
@@ -35,3 +35,13 @@ type Command interface {
 	// This enables testing flag registration independently of Cobra.
 	AddFlags(fs *pflag.FlagSet)
 }
+
+// ExitCoder is implemented by errors that request a specific process exit code.
+// Commands return them from Run to report an outcome (e.g. the lint verdict)
+// rather than a failure to run.
+type ExitCoder interface {
+	error
+
+	// ExitCode returns the process exit code for this error.
+	ExitCode() int
+}
@@ -0,0 +1,168 @@
+package lint
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+)
+
+// impactNoneLabel is shown for conditions reported when a check passes.
+const impactNoneLabel = "none"
+
+//nolint:gochecknoglobals
+var checkInfoTableHeaders = []string{"ID", "GROUP", "KIND", "TYPE", "NAME"}
+
+// CheckInfo is a registered check as printed by lint list.
+type CheckInfo struct {
+	ID    string `json:"id"    yaml:"id"`
+	Group string `json:"group" yaml:"group"`
+	Kind  string `json:"kind"  yaml:"kind"`
+	Type  string `json:"type"  yaml:"type"`
+	Name  string `json:"name"  yaml:"name"`
+
+	// Applies reports whether the check would run against the cluster. It is nil when
+	// no cluster was reachable or applicability could not be determined.
+	Applies *bool `json:"applies,omitempty" yaml:"applies,omitempty"`
+}
+
+// CheckExplanation is the documentation of a check as printed by lint explain.
+type CheckExplanation struct {
+	ID          string                `json:"id"                    yaml:"id"`
+	Name        string                `json:"name"                  yaml:"name"`
+	Group       string                `json:"group"                 yaml:"group"`
+	Kind        string                `json:"kind"                  yaml:"kind"`
+	Type        string                `json:"type"                  yaml:"type"`
+	Description string                `json:"description"           yaml:"description"`
+	Remediation string                `json:"remediation,omitempty" yaml:"remediation,omitempty"`
+	Conditions  []check.ConditionSpec `json:"conditions,omitempty"  yaml:"conditions,omitempty"`
+
+	// Impacts lists the distinct impact levels of Conditions, most severe first.
+	Impacts []result.Impact `json:"impacts,omitempty" yaml:"impacts,omitempty"`
+}
+
+// NewCheckInfo returns the list entry of a check.
+func NewCheckInfo(chk check.Check) CheckInfo {
+	return CheckInfo{
+		ID:    chk.ID(),
+		Group: string(chk.Group()),
+		Kind:  chk.CheckKind(),
+		Type:  chk.CheckType(),
+		Name:  chk.Name(),
+	}
+}
+
+// NewCheckExplanation returns the documentation of a check. Remediation and conditions are
+// only known for checks implementing check.Explainer.
+func NewCheckExplanation(chk check.Check) CheckExplanation {
+	explanation := CheckExplanation{
+		ID:          chk.ID(),
+		Name:        chk.Name(),
+		Group:       string(chk.Group()),
+		Kind:        chk.CheckKind(),
+		Type:        chk.CheckType(),
+		Description: chk.Description(),
+	}
+
+	explainer, ok := chk.(check.Explainer)
+	if !ok {
+		return explanation
+	}
+
+	explanation.Remediation = explainer.Remediation()
+	explanation.Conditions = explainer.Conditions()
+
+	for _, impact := range []result.Impact{result.ImpactProhibited, result.ImpactBlocking, result.ImpactAdvisory} {
+		if slices.ContainsFunc(explanation.Conditions, func(c check.ConditionSpec) bool { return c.Impact == impact }) {
+			explanation.Impacts = append(explanation.Impacts, impact)
+		}
+	}
+
+	return explanation
+}
+
+// OutputCheckInfoTable prints the listed checks as a table. The APPLIES column is only
+// shown when applicability was evaluated against a cluster.
+func OutputCheckInfoTable(out io.Writer, infos []CheckInfo, withApplies bool) error {
+	headers := checkInfoTableHeaders
+	if withApplies {
+		headers = append(slices.Clone(headers), "APPLIES")
+	}
+
+	renderer := table.NewRenderer[[]any](
+		table.WithWriter[[]any](out),
+		table.WithHeaders[[]any](headers...),
+		table.WithTableOptions[[]any](table.DefaultTableOptions...),
+	)
+
+	for _, info := range infos {
+		row := []any{info.ID, info.Group, info.Kind, info.Type, info.Name}
+
+		if withApplies {
+			applies := "unknown"
+			if info.Applies != nil {
+				applies = strconv.FormatBool(*info.Applies)
+			}
+
+			row = append(row, applies)
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("appending table row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering table: %w", err)
+	}
+
+	return nil
+}
+
+// OutputCheckExplanation prints the documentation of a check in human-readable form.
+func OutputCheckExplanation(out io.Writer, explanation CheckExplanation) {
+	_, _ = fmt.Fprintf(out, "ID:      %s\n", explanation.ID)
+	_, _ = fmt.Fprintf(out, "Name:    %s\n", explanation.Name)
+	_, _ = fmt.Fprintf(out, "Group:   %s\n", explanation.Group)
+	_, _ = fmt.Fprintf(out, "Kind:    %s\n", explanation.Kind)
+	_, _ = fmt.Fprintf(out, "Type:    %s\n", explanation.Type)
+
+	impacts := make([]string, 0, len(explanation.Impacts))
+	for _, impact := range explanation.Impacts {
+		impacts = append(impacts, string(impact))
+	}
+
+	if len(impacts) > 0 {
+		_, _ = fmt.Fprintf(out, "Impacts: %s\n", strings.Join(impacts, ", "))
+	}
+
+	_, _ = fmt.Fprintf(out, "\nDescription:\n  %s\n", explanation.Description)
+
+	if explanation.Remediation != "" {
+		_, _ = fmt.Fprintf(out, "\nRemediation:\n  %s\n", explanation.Remediation)
+	}
+
+	_, _ = fmt.Fprintln(out, "\nConditions:")
+
+	if len(explanation.Conditions) == 0 {
+		_, _ = fmt.Fprintln(out, "  Not documented; the conditions are defined by the check implementation.")
+	}
+
+	for _, c := range explanation.Conditions {
+		impact := string(c.Impact)
+		if c.Impact == result.ImpactNone {
+			impact = impactNoneLabel
+		}
+
+		_, _ = fmt.Fprintf(out, "  %-40s %s\n", c.Reason, impact)
+	}
+
+	_, _ = fmt.Fprintf(out, "\nAny check can also report %s or %s when it cannot be evaluated, and %s\n",
+		check.ReasonCheckExecutionFailed, check.ReasonAPIAccessDenied, check.ReasonCheckNotExecuted)
+	_, _ = fmt.Fprintln(out, "when the run ends before it completes.")
+}
@@ -23,6 +23,9 @@ import (
 //	            CheckName:        "Components :: ModelMesh :: Removal (3.x)",
 //	            CheckDescription: "Validates that ModelMesh is disabled...",
 //	            CheckRemediation: "",
+//	            CheckConditions: []check.ConditionSpec{
+//	                {Reason: check.ReasonVersionIncompatible, Impact: result.ImpactBlocking},
+//	            },
 //	        },
 //	    }
 //	}
@@ -34,6 +37,18 @@ type BaseCheck struct {
 	CheckName        string
 	CheckDescription string
 	CheckRemediation string
+
+	// CheckConditions documents the conditions the check can report, for lint explain.
+	CheckConditions []ConditionSpec
+}
+
+// ConditionSpec documents a condition a check can report.
+type ConditionSpec struct {
+	// Reason is the condition reason, e.g. ReasonVersionIncompatible.
+	Reason string `json:"reason" yaml:"reason"`
+
+	// Impact is the impact the condition is reported with; ImpactNone when it passes.
+	Impact result.Impact `json:"impact,omitempty" yaml:"impact,omitempty"`
 }
 
 // ID returns the unique identifier for this check.
@@ -59,6 +74,11 @@ func (b BaseCheck) Remediation() string {
 	return b.CheckRemediation
 }
 
+// Conditions returns the conditions the check can report.
+func (b BaseCheck) Conditions() []ConditionSpec {
+	return b.CheckConditions
+}
+
 // Group returns the check group.
 // Required by check.Check interface.
 func (b BaseCheck) Group() CheckGroup {
@@ -119,3 +119,10 @@ type Check interface {
 	// Returns DiagnosticResult following Kubernetes CR pattern with conditions
 	Validate(ctx context.Context, target Target) (*result.DiagnosticResult, error)
 }
+
+// Explainer is implemented by checks that document their remediation and the conditions
+// they can report, as shown by lint explain. Checks embedding BaseCheck implement it.
+type Explainer interface {
+	Remediation() string
+	Conditions() []ConditionSpec
+}
@@ -209,4 +209,27 @@ const (
 
 	// ReasonInsufficientData indicates insufficient data to determine status.
 	ReasonInsufficientData = "InsufficientData"
+
+	// ReasonCheckNotExecuted indicates the check did not run to completion because the
+	// run ended (timed out or was canceled) first.
+	ReasonCheckNotExecuted = "CheckNotExecuted"
 )
+
+// IsExecutionError returns true if the condition reports that the check could not be
+// executed (as built by the Executor from a Validate error), rather than a finding
+// about the cluster.
+func IsExecutionError(condition result.Condition) bool {
+	if condition.Status != metav1.ConditionUnknown {
+		return false
+	}
+
+	return condition.Reason == ReasonCheckExecutionFailed ||
+		condition.Reason == ReasonAPIAccessDenied ||
+		condition.Reason == ReasonCheckNotExecuted
+}
+
+// IsNotExecuted returns true if the condition reports that the check was not executed
+// because the run ended before it completed.
+func IsNotExecuted(condition result.Condition) bool {
+	return condition.Status == metav1.ConditionUnknown && condition.Reason == ReasonCheckNotExecuted
+}
@@ -127,6 +127,7 @@ func TestCondition_UnknownReasons(t *testing.T) {
 		check.ReasonCheckSkipped,
 		check.ReasonAPIAccessDenied,
 		check.ReasonInsufficientData,
+		check.ReasonCheckNotExecuted,
 	}
 
 	for _, reason := range unknownReasons {
@@ -503,3 +504,37 @@ func TestNewCondition_WithErrorFormatArg(t *testing.T) {
 		"Message": Equal("Check execution failed: connection timeout"),
 	}))
 }
+
+func TestIsExecutionError(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(check.IsExecutionError(check.NewCondition(
+		check.ConditionTypeValidated,
+		metav1.ConditionUnknown,
+		check.WithReason(check.ReasonCheckExecutionFailed),
+	))).To(BeTrue())
+
+	g.Expect(check.IsExecutionError(check.NewCondition(
+		check.ConditionTypeValidated,
+		metav1.ConditionUnknown,
+		check.WithReason(check.ReasonAPIAccessDenied),
+	))).To(BeTrue())
+
+	g.Expect(check.IsExecutionError(check.NewCondition(
+		check.ConditionTypeValidated,
+		metav1.ConditionUnknown,
+		check.WithReason(check.ReasonCheckNotExecuted),
+	))).To(BeTrue())
+
+	g.Expect(check.IsExecutionError(check.NewCondition(
+		check.ConditionTypeValidated,
+		metav1.ConditionUnknown,
+		check.WithReason(check.ReasonInsufficientData),
+	))).To(BeFalse())
+
+	g.Expect(check.IsExecutionError(check.NewCondition(
+		check.ConditionTypeCompatible,
+		metav1.ConditionFalse,
+		check.WithReason(check.ReasonCheckExecutionFailed),
+	))).To(BeFalse())
+}
@@ -1,13 +1,21 @@
 package check
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"golang.org/x/sync/errgroup"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/util"
 	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
 )
 
@@ -16,20 +24,65 @@ type CheckExecution struct {
 	Check  Check
 	Result *result.DiagnosticResult
 	Error  error
+
+	// Duration is the time the check ran, including CanApply; zero for checks that
+	// were not started.
+	Duration time.Duration
 }
 
 // Executor orchestrates check execution.
 type Executor struct {
 	registry *CheckRegistry
 	io       iostreams.Interface
+
+	// parallelism is the maximum number of checks run concurrently; 1 runs sequentially.
+	parallelism int
+
+	// checkTimeout bounds a single check; 0 leaves checks bounded by the run context only.
+	checkTimeout time.Duration
+
+	// checkTimeouts overrides checkTimeout for individual check IDs.
+	checkTimeouts map[string]time.Duration
+}
+
+// ExecutorOption is a functional option for configuring an Executor.
+type ExecutorOption = util.Option[Executor]
+
+// WithParallelism sets the maximum number of checks run concurrently.
+// Values below 1 are treated as 1 (sequential execution).
+func WithParallelism(n int) ExecutorOption {
+	return util.FunctionalOption[Executor](func(e *Executor) {
+		e.parallelism = max(n, 1)
+	})
+}
+
+// WithCheckTimeout sets the deadline of a single check. A check that exceeds it is
+// reported as failed and the run continues with the next check.
+func WithCheckTimeout(d time.Duration) ExecutorOption {
+	return util.FunctionalOption[Executor](func(e *Executor) {
+		e.checkTimeout = d
+	})
+}
+
+// WithCheckTimeouts overrides the check deadline for individual check IDs.
+func WithCheckTimeouts(timeouts map[string]time.Duration) ExecutorOption {
+	return util.FunctionalOption[Executor](func(e *Executor) {
+		e.checkTimeouts = timeouts
+	})
 }
 
 // NewExecutor creates a new check executor.
-func NewExecutor(registry *CheckRegistry, io iostreams.Interface) *Executor {
-	return &Executor{
-		registry: registry,
-		io:       io,
+// Checks run sequentially unless WithParallelism is provided.
+func NewExecutor(registry *CheckRegistry, io iostreams.Interface, opts ...ExecutorOption) *Executor {
+	e := &Executor{
+		registry:    registry,
+		io:          io,
+		parallelism: 1,
 	}
+
+	util.ApplyOptions(e, opts...)
+
+	return e
 }
 
 // ExecuteAll runs all checks in the registry against the target
@@ -57,40 +110,196 @@ func (e *Executor) ExecuteSelective(
 	return e.executeChecks(ctx, target, checks), nil
 }
 
-// executeChecks runs the provided checks against the target sequentially.
+// executeChecks runs the provided checks against the target, up to e.parallelism at a time.
+// Results are always returned in check ID order, regardless of completion order,
+// so output is deterministic for any parallelism.
 func (e *Executor) executeChecks(ctx context.Context, target Target, checks []Check) []CheckExecution {
-	results := make([]CheckExecution, 0, len(checks))
+	checks = slices.Clone(checks)
+	slices.SortFunc(checks, func(a, b Check) int {
+		return cmp.Compare(a.ID(), b.ID())
+	})
 
-	for _, check := range checks {
-		// Check context before executing each check
-		if err := CheckContextError(ctx); err != nil {
-			// Context canceled or timed out - stop executing checks
-			break
-		}
+	// Each check writes only its own slot, so no locking is needed.
+	executions := make([]*CheckExecution, len(checks))
+
+	if e.parallelism <= 1 {
+		for i, check := range checks {
+			// Checks after the context ends are reported as not executed
+			if err := CheckContextError(ctx); err != nil {
+				executions[i] = e.buildNotExecuted(check, err)
 
-		// Filter by CanApply before executing
-		// Checks can use target.CurrentVersion, target.TargetVersion, or target.Client for filtering
-		canApply, err := check.CanApply(ctx, target)
-		if err != nil {
-			results = append(results, e.buildCanApplyError(check, err))
+				continue
+			}
 
-			continue
+			executions[i] = e.runCheck(ctx, target, check)
 		}
+	} else {
+		var g errgroup.Group
+		g.SetLimit(e.parallelism)
+
+		for i, check := range checks {
+			g.Go(func() error {
+				// Checks still queued when the context ends are not started
+				if err := CheckContextError(ctx); err != nil {
+					executions[i] = e.buildNotExecuted(check, err)
+
+					return nil
+				}
 
-		if !canApply {
-			continue
+				executions[i] = e.runCheck(ctx, target, check)
+
+				return nil
+			})
 		}
 
-		// Execute check sequentially
-		exec := e.executeCheck(ctx, target, check)
-		if exec.Result != nil {
-			results = append(results, exec)
+		_ = g.Wait()
+	}
+
+	results := make([]CheckExecution, 0, len(checks))
+
+	for _, exec := range executions {
+		if exec != nil && exec.Result != nil {
+			setCheckID(exec.Result, exec.Check)
+			results = append(results, *exec)
 		}
 	}
 
 	return results
 }
 
+// runCheck filters a check by CanApply and executes it within the check deadline.
+// Returns nil when the check does not apply to the target.
+//
+// The check runs in its own goroutine so that a check ignoring its context cannot hold
+// up the run past the deadline; its late result is discarded.
+func (e *Executor) runCheck(ctx context.Context, target Target, check Check) (exec *CheckExecution) {
+	start := time.Now()
+
+	defer func() {
+		if exec != nil {
+			exec.Duration = time.Since(start)
+		}
+	}()
+
+	timeout := e.timeoutFor(check.ID())
+
+	var (
+		checkCtx context.Context
+		cancel   context.CancelFunc
+	)
+
+	if timeout > 0 {
+		checkCtx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		checkCtx, cancel = context.WithCancel(ctx)
+	}
+
+	defer cancel()
+
+	done := make(chan *CheckExecution, 1)
+
+	go func() {
+		done <- e.applyAndExecute(checkCtx, target, check)
+	}()
+
+	select {
+	case exec = <-done:
+		// A result that arrived despite the deadline is kept; errors caused by it are
+		// reported as timeouts below.
+		if checkCtx.Err() == nil || exec == nil || exec.Error == nil {
+			return exec
+		}
+	case <-checkCtx.Done():
+	}
+
+	// The run ended while the check was running.
+	if err := CheckContextError(ctx); err != nil {
+		return e.buildNotExecuted(check, err)
+	}
+
+	return e.buildTimeoutError(check, timeout)
+}
+
+// timeoutFor returns the deadline of the check with the given ID.
+func (e *Executor) timeoutFor(id string) time.Duration {
+	if d, ok := e.checkTimeouts[id]; ok {
+		return d
+	}
+
+	return e.checkTimeout
+}
+
+// applyAndExecute filters a check by CanApply and executes it.
+// Returns nil when the check does not apply to the target.
+func (e *Executor) applyAndExecute(ctx context.Context, target Target, check Check) *CheckExecution {
+	// Filter by CanApply before executing
+	// Checks can use target.CurrentVersion, target.TargetVersion, or target.Client for filtering
+	canApply, err := check.CanApply(ctx, target)
+	if err != nil {
+		exec := e.buildCanApplyError(check, err)
+
+		return &exec
+	}
+
+	if !canApply {
+		return nil
+	}
+
+	exec := e.executeCheck(ctx, target, check)
+
+	return &exec
+}
+
+// buildTimeoutError creates a CheckExecution for a check that exceeded its deadline.
+func (e *Executor) buildTimeoutError(check Check, timeout time.Duration) *CheckExecution {
+	if e.io != nil {
+		e.io.Errorf("Check timed out after %s - Check: %s", timeout, check.Name())
+	}
+
+	return &CheckExecution{
+		Check:  check,
+		Result: newErrorResult(check, ReasonCheckExecutionFailed, "Check timed out after %s", timeout),
+		Error:  fmt.Errorf("check %s: %w after %s", check.ID(), ErrCheckTimeout, timeout),
+	}
+}
+
+// buildNotExecuted creates a CheckExecution for a check that did not complete because
+// the run ended, with err the reason returned by CheckContextError.
+func (e *Executor) buildNotExecuted(check Check, err error) *CheckExecution {
+	cause := "the run was canceled"
+	if errors.Is(err, ErrCheckTimeout) {
+		cause = "the run timed out"
+	}
+
+	return &CheckExecution{
+		Check:  check,
+		Result: newErrorResult(check, ReasonCheckNotExecuted, "Check was not executed: %s", cause),
+		Error:  fmt.Errorf("check %s not executed: %w", check.ID(), err),
+	}
+}
+
+// newErrorResult creates a result with a single Unknown condition reporting that the
+// check could not be evaluated.
+func newErrorResult(check Check, reason string, format string, args ...any) *result.DiagnosticResult {
+	errorResult := result.New(
+		string(check.Group()),
+		check.CheckKind(),
+		check.CheckType(),
+		check.Description(),
+	)
+
+	errorResult.Status.Conditions = []result.Condition{
+		NewCondition(
+			ConditionTypeValidated,
+			metav1.ConditionUnknown,
+			WithReason(reason),
+			WithMessage(format, args...),
+		),
+	}
+
+	return errorResult
+}
+
 // buildCanApplyError creates a CheckExecution for a CanApply error.
 func (e *Executor) buildCanApplyError(check Check, err error) CheckExecution {
 	errorResult := result.New(
@@ -123,6 +332,11 @@ func (e *Executor) executeCheck(ctx context.Context, target Target, check Check)
 		target.IO = e.io
 	}
 
+	// Namespace scoping only applies to workloads; other checks stay cluster-wide.
+	if check.Group() != GroupWorkload {
+		target.Namespaces = nil
+	}
+
 	checkResult, err := check.Validate(ctx, target)
 
 	// Nil result signals the check should be silently skipped.
@@ -159,6 +373,12 @@ func (e *Executor) executeCheck(ctx context.Context, target Target, check Check)
 		}
 	}
 
+	// Checks that do not filter their own listings, such as plugins, still only
+	// report workloads in scope.
+	if check.Group() == GroupWorkload {
+		filterImpactedObjects(checkResult, target.Namespaces)
+	}
+
 	return CheckExecution{
 		Check:  check,
 		Result: checkResult,
@@ -166,6 +386,29 @@ func (e *Executor) executeCheck(ctx context.Context, target Target, check Check)
 	}
 }
 
+// filterImpactedObjects drops the impacted objects of dr outside scope and lowers the
+// impacted workload count annotation by the number of objects dropped.
+func filterImpactedObjects(dr *result.DiagnosticResult, scope *NamespaceScope) {
+	if scope == nil || len(dr.ImpactedObjects) == 0 {
+		return
+	}
+
+	before := len(dr.ImpactedObjects)
+
+	dr.ImpactedObjects = slices.DeleteFunc(dr.ImpactedObjects, func(obj metav1.PartialObjectMetadata) bool {
+		return !scope.Contains(obj.GetNamespace())
+	})
+
+	dropped := before - len(dr.ImpactedObjects)
+	if dropped == 0 {
+		return
+	}
+
+	if count, err := strconv.Atoi(dr.Annotations[AnnotationImpactedWorkloadCount]); err == nil {
+		dr.Annotations[AnnotationImpactedWorkloadCount] = strconv.Itoa(max(count-dropped, 0))
+	}
+}
+
 // buildValidateError creates a CheckExecution for a Validate error,
 // classifying the error into an appropriate reason and message.
 func (e *Executor) buildValidateError(check Check, err error) CheckExecution {
@@ -230,3 +473,13 @@ func (e *Executor) buildValidateError(check Check, err error) CheckExecution {
 		Error:  err,
 	}
 }
+
+// setCheckID records the ID of check on its result, so that checks sharing a group, kind
+// and type can be told apart in reports.
+func setCheckID(r *result.DiagnosticResult, check Check) {
+	if r.Annotations == nil {
+		r.Annotations = make(map[string]string)
+	}
+
+	r.Annotations[result.AnnotationCheckID] = check.ID()
+}
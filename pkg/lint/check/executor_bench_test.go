@@ -2,16 +2,21 @@ package check_test
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/blang/semver/v4"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 )
 
@@ -99,6 +104,47 @@ func BenchmarkExecuteSelective_SingleCheck(b *testing.B) {
 	}
 }
 
+// BenchmarkExecuteSelective_Parallelism benchmarks a workload group of checks that each
+// spend time waiting on the API server, at increasing parallelism.
+func BenchmarkExecuteSelective_Parallelism(b *testing.B) {
+	registry := check.NewRegistry()
+
+	// Simulates listing a large resource type against a real API server
+	for i := range 10 {
+		chk := newBenchmarkCheck("workloads", i)
+		chk.latency = 2 * time.Millisecond
+		_ = registry.Register(chk)
+	}
+
+	scheme := runtime.NewScheme()
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme)
+	c := client.NewForTesting(client.TestClientConfig{
+		Dynamic: dynamicClient,
+	})
+
+	ver := semver.MustParse("2.17.0")
+	target := check.Target{
+		Client:        c,
+		TargetVersion: &ver,
+		Resource:      nil,
+	}
+
+	ctx := context.Background()
+
+	for _, parallelism := range []int{1, 4, 8} {
+		executor := check.NewExecutor(registry, nil, check.WithParallelism(parallelism))
+
+		b.Run(fmt.Sprintf("parallelism=%d", parallelism), func(b *testing.B) {
+			for b.Loop() {
+				_, err := executor.ExecuteSelective(ctx, target, []string{"*"}, check.GroupWorkload)
+				if err != nil {
+					b.Fatalf("ExecuteSelective failed: %v", err)
+				}
+			}
+		})
+	}
+}
+
 // setupBenchmarkRegistry creates a registry with representative checks.
 func setupBenchmarkRegistry() *check.CheckRegistry {
 	registry := check.NewRegistry()
@@ -131,6 +177,12 @@ func setupBenchmarkRegistry() *check.CheckRegistry {
 type benchmarkCheck struct {
 	id    string
 	group check.CheckGroup
+
+	// latency simulates time spent waiting on the API server during Validate.
+	latency time.Duration
+
+	// impacted are the Notebooks reported as impacted objects.
+	impacted []types.NamespacedName
 }
 
 func newBenchmarkCheck(categoryStr string, index int) *benchmarkCheck {
@@ -180,7 +232,15 @@ func (c *benchmarkCheck) CanApply(_ context.Context, _ check.Target) (bool, erro
 	return true, nil // Always applicable
 }
 
-func (c *benchmarkCheck) Validate(_ context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+func (c *benchmarkCheck) Validate(ctx context.Context, _ check.Target) (*result.DiagnosticResult, error) {
+	if c.latency > 0 {
+		select {
+		case <-time.After(c.latency):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
 	dr := result.New(
 		string(c.group),
 		"bench"+string(rune('0'+len(c.id))),
@@ -196,5 +256,10 @@ func (c *benchmarkCheck) Validate(_ context.Context, _ check.Target) (*result.Di
 		),
 	}
 
+	if len(c.impacted) > 0 {
+		dr.SetImpactedObjects(resources.Notebook, c.impacted)
+		dr.Annotations[check.AnnotationImpactedWorkloadCount] = strconv.Itoa(len(c.impacted))
+	}
+
 	return dr, nil
 }
@@ -0,0 +1,183 @@
+package check_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/blang/semver/v4"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestExecutor_ParallelismPreservesOrder(t *testing.T) {
+	registry := check.NewRegistry()
+
+	// Later IDs finish first, so completion order is the reverse of ID order.
+	for i := range 8 {
+		chk := newBenchmarkCheck("workloads", i)
+		chk.latency = time.Duration(8-i) * time.Millisecond
+		registry.MustRegister(chk)
+	}
+
+	ver := semver.MustParse("3.0.0")
+	target := check.Target{TargetVersion: &ver}
+
+	for _, parallelism := range []int{0, 1, 3, 8} {
+		t.Run(fmt.Sprintf("parallelism=%d", parallelism), func(t *testing.T) {
+			g := NewWithT(t)
+
+			executor := check.NewExecutor(registry, nil, check.WithParallelism(parallelism))
+
+			results, err := executor.ExecuteSelective(t.Context(), target, []string{"*"}, check.GroupWorkload)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(results).To(HaveLen(8))
+
+			for i, exec := range results {
+				g.Expect(exec.Check.ID()).To(Equal(newBenchmarkCheck("workloads", i).ID()))
+				g.Expect(exec.Error).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestExecutor_CanceledContextReportsNotExecuted(t *testing.T) {
+	registry := check.NewRegistry()
+
+	for i := range 4 {
+		registry.MustRegister(newBenchmarkCheck("workloads", i))
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	ver := semver.MustParse("3.0.0")
+
+	for _, parallelism := range []int{1, 4} {
+		t.Run(fmt.Sprintf("parallelism=%d", parallelism), func(t *testing.T) {
+			g := NewWithT(t)
+
+			executor := check.NewExecutor(registry, nil, check.WithParallelism(parallelism))
+
+			results, err := executor.ExecuteSelective(ctx, check.Target{TargetVersion: &ver}, []string{"*"}, "")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(results).To(HaveLen(4))
+
+			for _, exec := range results {
+				g.Expect(exec.Error).To(MatchError(check.ErrCheckCanceled))
+				g.Expect(exec.Result.Status.Conditions).To(HaveExactElements(
+					WithTransform(check.IsNotExecuted, BeTrue()),
+				))
+				g.Expect(exec.Result.Status.Conditions[0].Message).To(ContainSubstring("the run was canceled"))
+			}
+		})
+	}
+}
+
+func TestExecutor_CheckTimeout(t *testing.T) {
+	registry := check.NewRegistry()
+
+	slow := newBenchmarkCheck("workloads", 0)
+	slow.latency = time.Minute
+	registry.MustRegister(slow)
+
+	slower := newBenchmarkCheck("workloads", 1)
+	slower.latency = 200 * time.Millisecond
+	registry.MustRegister(slower)
+
+	registry.MustRegister(newBenchmarkCheck("workloads", 2))
+
+	ver := semver.MustParse("3.0.0")
+	target := check.Target{TargetVersion: &ver}
+
+	t.Run("checks exceeding the deadline fail and the run continues", func(t *testing.T) {
+		g := NewWithT(t)
+
+		executor := check.NewExecutor(registry, nil,
+			check.WithCheckTimeout(20*time.Millisecond),
+			check.WithCheckTimeouts(map[string]time.Duration{slower.ID(): time.Minute}),
+		)
+
+		results, err := executor.ExecuteSelective(t.Context(), target, []string{"*"}, "")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(results).To(HaveLen(3))
+
+		g.Expect(results[0].Error).To(MatchError(check.ErrCheckTimeout))
+		g.Expect(results[0].Result.Status.Conditions).To(HaveExactElements(And(
+			HaveField("Reason", check.ReasonCheckExecutionFailed),
+			HaveField("Message", "Check timed out after 20ms"),
+		)))
+
+		// The override gives the slower check enough time to complete.
+		g.Expect(results[1].Error).ToNot(HaveOccurred())
+		g.Expect(results[1].Duration).To(BeNumerically(">=", slower.latency))
+		g.Expect(results[2].Error).ToNot(HaveOccurred())
+	})
+
+	t.Run("checks interrupted by the run deadline are not executed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+		defer cancel()
+
+		executor := check.NewExecutor(registry, nil, check.WithCheckTimeout(time.Minute))
+
+		results, err := executor.ExecuteSelective(ctx, target, []string{"*"}, "")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(results).To(HaveLen(3))
+
+		for _, exec := range results {
+			g.Expect(exec.Result.Status.Conditions).To(HaveExactElements(And(
+				WithTransform(check.IsNotExecuted, BeTrue()),
+				HaveField("Message", "Check was not executed: the run timed out"),
+			)))
+		}
+	})
+}
+
+func TestExecutor_NamespaceScopeFiltersImpactedObjects(t *testing.T) {
+	impacted := []types.NamespacedName{
+		{Namespace: "team-a", Name: "wb-a"},
+		{Namespace: "team-b", Name: "wb-b"},
+		{Namespace: "team-c", Name: "wb-c"},
+	}
+
+	registry := check.NewRegistry()
+
+	workload := newBenchmarkCheck("workloads", 0)
+	workload.impacted = impacted
+	registry.MustRegister(workload)
+
+	component := newBenchmarkCheck("components", 0)
+	component.impacted = impacted
+	registry.MustRegister(component)
+
+	scope, err := check.NewNamespaceScope([]string{"team-a", "team-b"}, "", []string{"team-b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ver := semver.MustParse("3.0.0")
+	target := check.Target{TargetVersion: &ver, Namespaces: scope}
+
+	g := NewWithT(t)
+
+	results, err := check.NewExecutor(registry, nil).ExecuteSelective(t.Context(), target, []string{"*"}, "")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	for _, exec := range results {
+		switch exec.Check.Group() {
+		case check.GroupWorkload:
+			g.Expect(exec.Result.ImpactedObjects).To(HaveExactElements(HaveField("Name", "wb-a")))
+			g.Expect(exec.Result.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "1"))
+		default:
+			// Namespace scoping only applies to workloads.
+			g.Expect(exec.Result.ImpactedObjects).To(HaveLen(3))
+		}
+	}
+}
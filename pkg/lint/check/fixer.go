@@ -0,0 +1,115 @@
+package check
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+// PatchType is the patch strategy of a Patch, named as accepted by `kubectl patch --type`.
+type PatchType string
+
+const (
+	// PatchTypeMerge is a JSON merge patch (RFC 7386).
+	PatchTypeMerge PatchType = "merge"
+
+	// PatchTypeJSON is a JSON patch (RFC 6902).
+	PatchTypeJSON PatchType = "json"
+)
+
+// APIPatchType returns the Kubernetes API patch type for p.
+func (p PatchType) APIPatchType() (types.PatchType, error) {
+	switch p {
+	case PatchTypeMerge:
+		return types.MergePatchType, nil
+	case PatchTypeJSON:
+		return types.JSONPatchType, nil
+	}
+
+	return "", fmt.Errorf("unsupported patch type %q", p)
+}
+
+// Patch is a remediation for a single object.
+type Patch struct {
+	// Resource is the resource type of the patched object.
+	Resource resources.ResourceType `json:"-"`
+
+	// Namespace of the patched object; empty for cluster-scoped objects.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the patched object.
+	Name string `json:"name"`
+
+	// Type is the patch strategy.
+	Type PatchType `json:"type"`
+
+	// Data is the patch document.
+	Data json.RawMessage `json:"data"`
+
+	// Description explains what the patch changes.
+	Description string `json:"description"`
+}
+
+// Fixer is optionally implemented by checks whose remediation is mechanical.
+// Fix is called only for results with failing conditions, and returns one patch per
+// impacted object it can remediate. Objects the check cannot fix safely are skipped.
+type Fixer interface {
+	Fix(ctx context.Context, target Target, dr *result.DiagnosticResult) ([]Patch, error)
+}
+
+// NewMergePatch returns a merge Patch for the object, marshaling data as the patch document.
+func NewMergePatch(
+	resourceType resources.ResourceType,
+	namespace string,
+	name string,
+	data any,
+	description string,
+) (Patch, error) {
+	return newPatch(resourceType, namespace, name, PatchTypeMerge, data, description)
+}
+
+// JSONPatchOperation is a single RFC 6902 operation.
+type JSONPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value,omitempty"`
+}
+
+// NewJSONPatch returns a JSON Patch for the object with the given operations.
+func NewJSONPatch(
+	resourceType resources.ResourceType,
+	namespace string,
+	name string,
+	ops []JSONPatchOperation,
+	description string,
+) (Patch, error) {
+	return newPatch(resourceType, namespace, name, PatchTypeJSON, ops, description)
+}
+
+func newPatch(
+	resourceType resources.ResourceType,
+	namespace string,
+	name string,
+	patchType PatchType,
+	data any,
+	description string,
+) (Patch, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return Patch{}, fmt.Errorf("marshaling patch for %s %s: %w", resourceType.Kind, name, err)
+	}
+
+	return Patch{
+		Resource:    resourceType,
+		Namespace:   namespace,
+		Name:        name,
+		Type:        patchType,
+		Data:        raw,
+		Description: description,
+	}, nil
+}
@@ -126,7 +126,7 @@ func (r *CheckRegistry) ListByPatterns(
 
 		// Match against any pattern
 		for _, pattern := range patterns {
-			matched, err := matchesPattern(check, pattern)
+			matched, err := MatchesPattern(check, pattern)
 			if err != nil {
 				return nil, fmt.Errorf("pattern matching for check %s: %w", check.ID(), err)
 			}
@@ -29,6 +29,14 @@ const (
 	// forms, avoiding naive derivation from Kind. Especially useful for multi-kind results
 	// where the result-level AnnotationResourceCRDName cannot represent all types.
 	AnnotationObjectCRDName = "result.opendatahub.io/crd-name"
+
+	// AnnotationObjectWaived is set on an ImpactedObject's ObjectMeta.Annotations when
+	// the object is covered by a lint waiver. The value is the waiver reason.
+	AnnotationObjectWaived = "result.opendatahub.io/waived"
+
+	// AnnotationCheckID is the ID of the check that produced the result. Set by the
+	// executor, since several checks can share the same group, kind and name.
+	AnnotationCheckID = "check.opendatahub.io/id"
 )
 
 const (
@@ -67,6 +75,43 @@ type Condition struct {
 	// Remediation provides actionable guidance on how to resolve the condition.
 	// Set via WithRemediation option during condition creation.
 	Remediation string `json:"remediation,omitempty" yaml:"remediation,omitempty"`
+
+	// Waiver is set when the condition was accepted via a lint waiver.
+	// Waived conditions are downgraded to advisory and do not affect the verdict.
+	Waiver *Waiver `json:"waiver,omitempty" yaml:"waiver,omitempty"`
+}
+
+// Waiver records that a failing condition was accepted, and why.
+type Waiver struct {
+	// Reason explains why the finding is accepted.
+	Reason string `json:"reason" yaml:"reason"`
+
+	// Expires is the last day (YYYY-MM-DD) the waiver applies.
+	Expires string `json:"expires" yaml:"expires"`
+
+	// OriginalImpact is the impact of the condition before it was waived.
+	OriginalImpact Impact `json:"originalImpact" yaml:"originalImpact"`
+}
+
+// IsWaived returns true if the condition was accepted via a waiver.
+func (c Condition) IsWaived() bool {
+	return c.Waiver != nil
+}
+
+// Waive marks a False condition as waived and downgrades prohibited or blocking impact
+// to advisory. Conditions that are met, could not be evaluated (Unknown), or are already
+// waived are left unchanged.
+func (c *Condition) Waive(reason string, expires string) {
+	if c.Status != metav1.ConditionFalse || c.Waiver != nil {
+		return
+	}
+
+	c.Waiver = &Waiver{
+		Reason:         reason,
+		Expires:        expires,
+		OriginalImpact: c.Impact,
+	}
+	c.Impact = ImpactAdvisory
 }
 
 // Validate ensures the condition has valid Status/Impact combination.
@@ -241,6 +286,17 @@ func New(
 	}
 }
 
+// CheckID returns the ID of the check that produced the result, from
+// AnnotationCheckID. Results without the annotation, such as reports written by older
+// versions, fall back to group.kind.name.
+func (r *DiagnosticResult) CheckID() string {
+	if id := r.Annotations[AnnotationCheckID]; id != "" {
+		return id
+	}
+
+	return r.Group + "." + r.Kind + "." + r.Name
+}
+
 // IsFailing returns true if any condition has status False or Unknown.
 func (r *DiagnosticResult) IsFailing() bool {
 	for _, cond := range r.Status.Conditions {
@@ -284,6 +340,22 @@ func (r *DiagnosticResult) GetImpact() Impact {
 	return maxImpact
 }
 
+// GetUnwaivedImpact returns the highest impact level across conditions that are not waived.
+// Returns ImpactNone if every failing condition is waived.
+func (r *DiagnosticResult) GetUnwaivedImpact() Impact {
+	unwaived := DiagnosticResult{
+		Status: DiagnosticStatus{Conditions: make([]Condition, 0, len(r.Status.Conditions))},
+	}
+
+	for _, cond := range r.Status.Conditions {
+		if !cond.IsWaived() {
+			unwaived.Status.Conditions = append(unwaived.Status.Conditions, cond)
+		}
+	}
+
+	return unwaived.GetImpact()
+}
+
 // GetRemediation returns remediation guidance from the first condition that has it set.
 func (r *DiagnosticResult) GetRemediation() string {
 	for _, cond := range r.Status.Conditions {
@@ -387,9 +459,18 @@ type DiagnosticResultList struct {
 	ClusterVersion   *string             `json:"clusterVersion,omitempty"   yaml:"clusterVersion,omitempty"`
 	TargetVersion    *string             `json:"targetVersion,omitempty"    yaml:"targetVersion,omitempty"`
 	OpenShiftVersion *string             `json:"openShiftVersion,omitempty" yaml:"openShiftVersion,omitempty"`
+	WorkloadScope    *WorkloadScope      `json:"workloadScope,omitempty"    yaml:"workloadScope,omitempty"`
 	Results          []*DiagnosticResult `json:"results"                    yaml:"results"`
 }
 
+// WorkloadScope records the namespaces workload checks were limited to. Omitted from the
+// report when workload checks ran cluster-wide.
+type WorkloadScope struct {
+	Namespaces         []string `json:"namespaces,omitempty"         yaml:"namespaces,omitempty"`
+	NamespaceSelector  string   `json:"namespaceSelector,omitempty"  yaml:"namespaceSelector,omitempty"`
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty" yaml:"excludedNamespaces,omitempty"`
+}
+
 // NewDiagnosticResultList creates a new list.
 func NewDiagnosticResultList(
 	clusterVersion *string,
@@ -0,0 +1,147 @@
+package check
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+)
+
+// NamespaceScope limits workload checks to a subset of namespaces. A nil scope is
+// cluster-wide. Component, platform and dependency checks are never scoped.
+type NamespaceScope struct {
+	// Namespaces lists the namespaces to include. Empty includes every namespace.
+	Namespaces []string
+
+	// Selector is a label selector namespaces must match. Empty matches every namespace.
+	Selector string
+
+	// Excluded lists namespaces to leave out, even if included otherwise.
+	Excluded []string
+
+	// selected holds the namespaces matching Selector, set by Resolve.
+	selected map[string]struct{}
+}
+
+// NewNamespaceScope returns the scope for the given namespaces, namespace label selector and
+// excluded namespaces, or nil if all of them are empty. The selector is validated but only
+// evaluated by Resolve.
+func NewNamespaceScope(namespaces []string, selector string, excluded []string) (*NamespaceScope, error) {
+	if len(namespaces) == 0 && selector == "" && len(excluded) == 0 {
+		return nil, nil
+	}
+
+	if selector != "" {
+		if _, err := labels.Parse(selector); err != nil {
+			return nil, fmt.Errorf("invalid namespace selector %q: %w", selector, err)
+		}
+	}
+
+	return &NamespaceScope{
+		Namespaces: namespaces,
+		Selector:   selector,
+		Excluded:   excluded,
+	}, nil
+}
+
+// Resolve returns a copy of the scope with the namespaces matching Selector listed from
+// reader. Contains only matches selected namespaces on a resolved scope, so a scope with a
+// selector must be resolved before use.
+func (s *NamespaceScope) Resolve(ctx context.Context, reader client.Reader) (*NamespaceScope, error) {
+	if s == nil || s.Selector == "" {
+		return s, nil
+	}
+
+	items, err := reader.ListMetadata(ctx, resources.Namespace, client.WithLabelSelector(s.Selector))
+	if err != nil {
+		return nil, fmt.Errorf("listing namespaces matching %q: %w", s.Selector, err)
+	}
+
+	resolved := *s
+	resolved.selected = make(map[string]struct{}, len(items))
+
+	for _, item := range items {
+		resolved.selected[item.GetName()] = struct{}{}
+	}
+
+	return &resolved, nil
+}
+
+// Contains reports whether objects of namespace are in scope. Cluster-scoped objects
+// (empty namespace) are always in scope.
+func (s *NamespaceScope) Contains(namespace string) bool {
+	if s == nil || namespace == "" {
+		return true
+	}
+
+	if slices.Contains(s.Excluded, namespace) {
+		return false
+	}
+
+	if len(s.Namespaces) > 0 && !slices.Contains(s.Namespaces, namespace) {
+		return false
+	}
+
+	if s.Selector != "" {
+		_, ok := s.selected[namespace]
+
+		return ok
+	}
+
+	return true
+}
+
+// InScope returns the items in scope, reusing the backing array of items.
+func InScope[T kube.NamespacedNamer](scope *NamespaceScope, items []T) []T {
+	if scope == nil {
+		return items
+	}
+
+	return slices.DeleteFunc(items, func(item T) bool {
+		return !scope.Contains(item.GetNamespace())
+	})
+}
+
+// Report returns the scope as recorded in reports, or nil when cluster-wide.
+func (s *NamespaceScope) Report() *result.WorkloadScope {
+	if s == nil {
+		return nil
+	}
+
+	return &result.WorkloadScope{
+		Namespaces:         s.Namespaces,
+		NamespaceSelector:  s.Selector,
+		ExcludedNamespaces: s.Excluded,
+	}
+}
+
+// String describes the scope for report headers, e.g.
+// "namespaces team-a, team-b; selector tenant=acme; excluding team-c".
+func (s *NamespaceScope) String() string {
+	if s == nil {
+		return "cluster-wide"
+	}
+
+	var parts []string
+
+	if len(s.Namespaces) > 0 {
+		parts = append(parts, "namespaces "+strings.Join(s.Namespaces, ", "))
+	}
+
+	if s.Selector != "" {
+		parts = append(parts, "selector "+s.Selector)
+	}
+
+	if len(s.Excluded) > 0 {
+		parts = append(parts, "excluding "+strings.Join(s.Excluded, ", "))
+	}
+
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,80 @@
+package check_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func newNamespace(name string, labels map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Namespace.APIVersion(),
+			"kind":       resources.Namespace.Kind,
+			"metadata":   map[string]any{"name": name, "labels": labels},
+		},
+	}
+}
+
+func TestNamespaceScope(t *testing.T) {
+	t.Run("should be nil without any namespace option", func(t *testing.T) {
+		g := NewWithT(t)
+
+		scope, err := check.NewNamespaceScope(nil, "", nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(scope).To(BeNil())
+		g.Expect(scope.Contains("team-a")).To(BeTrue())
+		g.Expect(scope.Report()).To(BeNil())
+	})
+
+	t.Run("should reject an invalid selector", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := check.NewNamespaceScope(nil, "tenant in (", nil)
+		g.Expect(err).To(MatchError(ContainSubstring("invalid namespace selector")))
+	})
+
+	t.Run("should include listed namespaces and leave out excluded ones", func(t *testing.T) {
+		g := NewWithT(t)
+
+		scope, err := check.NewNamespaceScope([]string{"team-a", "team-b"}, "", []string{"team-b"})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(scope.Contains("team-a")).To(BeTrue())
+		g.Expect(scope.Contains("team-b")).To(BeFalse())
+		g.Expect(scope.Contains("team-c")).To(BeFalse())
+		g.Expect(scope.Contains("")).To(BeTrue())
+		g.Expect(scope.String()).To(Equal("namespaces team-a, team-b; excluding team-b"))
+	})
+
+	t.Run("should match the namespaces selected by label", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: map[schema.GroupVersionResource]string{
+				resources.Namespace.GVR(): resources.Namespace.ListKind(),
+			},
+			Objects: []*unstructured.Unstructured{
+				newNamespace("team-a", map[string]any{"tenant": "acme"}),
+				newNamespace("team-b", map[string]any{"tenant": "other"}),
+			},
+		})
+
+		scope, err := check.NewNamespaceScope(nil, "tenant=acme", nil)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		resolved, err := scope.Resolve(t.Context(), target.Client)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(resolved.Contains("team-a")).To(BeTrue())
+		g.Expect(resolved.Contains("team-b")).To(BeFalse())
+		g.Expect(resolved.Report().NamespaceSelector).To(Equal("tenant=acme"))
+	})
+}
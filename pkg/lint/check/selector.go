@@ -15,13 +15,13 @@ const (
 	SelectorWorkloads    = "workloads"
 )
 
-// matchesPattern returns true if the check matches the selector pattern
+// MatchesPattern returns true if the check matches the selector pattern.
 // Pattern can be:
 //   - Wildcard: "*" matches all checks
 //   - Group shortcut: "components", "services", "workloads", "dependencies", "platform"
 //   - Exact ID: "components.dashboard"
 //   - Glob pattern: "components.*", "*dashboard*", "*.dashboard"
-func matchesPattern(check Check, pattern string) (bool, error) {
+func MatchesPattern(check Check, pattern string) (bool, error) {
 	// Wildcard matches all
 	if pattern == "*" {
 		return true, nil
@@ -48,7 +48,7 @@ func TestMatchesPattern_Wildcard(t *testing.T) {
 			mockCheck.On("ID").Return(tt.checkID)
 			mockCheck.On("Group").Return(tt.group)
 
-			// matchesPattern is not exported, so we test through ListByPattern
+			// MatchesPattern is exercised through ListByPattern, as the registry uses it
 			registry := check.NewRegistry()
 			g.Expect(registry.Register(mockCheck)).To(Succeed())
 
@@ -32,6 +32,10 @@ type Target struct {
 	// Nil for component and service checks
 	Resource *unstructured.Unstructured
 
+	// Namespaces limits workload checks to a subset of namespaces (optional)
+	// Nil means cluster-wide. The executor only passes it to workload checks.
+	Namespaces *NamespaceScope
+
 	// IO provides access to input/output streams for logging (optional)
 	// Used by checks to log warnings (e.g., permission errors) when verbose mode is enabled
 	// If nil, checks should skip logging
@@ -40,7 +40,7 @@ func FindWorkloadsWithAcceleratorRefs(
 		return nil, 0, fmt.Errorf("listing %s: %w", workloadType.Kind, err)
 	}
 
-	return FilterWorkloadsWithAcceleratorRefs(ctx, target.Client, workloads)
+	return FilterWorkloadsWithAcceleratorRefs(ctx, target.Client, check.InScope(target.Namespaces, workloads))
 }
 
 // FilterWorkloadsWithAcceleratorRefs checks which of the given workload items reference
@@ -3,6 +3,7 @@ package validate
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -46,7 +47,7 @@ type WorkloadBuilder[T kube.NamespacedNamer] struct {
 	check          check.Check
 	target         check.Target
 	resourceType   resources.ResourceType
-	listFn         func(ctx context.Context) ([]T, error)
+	listFn         func(ctx context.Context, opts ...client.ListResourcesOption) ([]T, error)
 	filterFn       func(T) (bool, error)
 	componentNames []string
 }
@@ -62,8 +63,8 @@ func Workloads(
 		check:        c,
 		target:       target,
 		resourceType: resourceType,
-		listFn: func(ctx context.Context) ([]*unstructured.Unstructured, error) {
-			return target.Client.List(ctx, resourceType)
+		listFn: func(ctx context.Context, opts ...client.ListResourcesOption) ([]*unstructured.Unstructured, error) {
+			return target.Client.List(ctx, resourceType, opts...)
 		},
 	}
 }
@@ -79,8 +80,8 @@ func WorkloadsMetadata(
 		check:        c,
 		target:       target,
 		resourceType: resourceType,
-		listFn: func(ctx context.Context) ([]*metav1.PartialObjectMetadata, error) {
-			return target.Client.ListMetadata(ctx, resourceType)
+		listFn: func(ctx context.Context, opts ...client.ListResourcesOption) ([]*metav1.PartialObjectMetadata, error) {
+			return target.Client.ListMetadata(ctx, resourceType, opts...)
 		},
 	}
 }
@@ -135,11 +136,14 @@ func (b *WorkloadBuilder[T]) Run(
 	}
 
 	// List resources; treat CRD-not-found as empty list.
-	items, err := b.listFn(ctx)
+	items, err := b.list(ctx)
 	if err != nil && !client.IsResourceTypeNotFound(err) {
 		return nil, fmt.Errorf("listing %s resources: %w", b.resourceType.Kind, err)
 	}
 
+	// Leave out items outside the namespace selector or in excluded namespaces.
+	items = check.InScope(b.target.Namespaces, items)
+
 	// Apply filter if set.
 	if b.filterFn != nil {
 		filtered := make([]T, 0, len(items))
@@ -179,6 +183,33 @@ func (b *WorkloadBuilder[T]) Run(
 	return dr, nil
 }
 
+// list lists the resources. When the scope names namespaces, each of them is listed on
+// its own, so that tenant-scoped credentials suffice and the API server does not serve
+// the whole cluster; otherwise the resources are listed cluster-wide.
+func (b *WorkloadBuilder[T]) list(ctx context.Context) ([]T, error) {
+	scope := b.target.Namespaces
+	if scope == nil || len(scope.Namespaces) == 0 {
+		return b.listFn(ctx)
+	}
+
+	var items []T
+
+	for _, ns := range scope.Namespaces {
+		if slices.Contains(scope.Excluded, ns) {
+			continue
+		}
+
+		nsItems, err := b.listFn(ctx, client.WithNamespace(ns))
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, nsItems...)
+	}
+
+	return items, nil
+}
+
 // checkComponentState verifies at least one component is not in Removed state.
 // Returns (true, nil) if at least one component is active, or (false, nil) if
 // all components are Removed or the DSC is not found.
@@ -7,12 +7,14 @@ import (
 
 	"github.com/blang/semver/v4"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
 	metadatafake "k8s.io/client-go/metadata/fake"
+	clienttesting "k8s.io/client-go/testing"
 
 	"github.com/opendatahub-io/odh-cli/pkg/constants"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
@@ -117,6 +119,114 @@ func TestWorkloadBuilder_MetadataListing_NoFilter_AutoPopulate(t *testing.T) {
 	g.Expect(dr.ImpactedObjects[1].Name).To(Equal("nb-2"))
 }
 
+func TestWorkloadBuilder_NamespaceScope(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	nb1 := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Notebook.APIVersion(),
+			"kind":       resources.Notebook.Kind,
+			"metadata":   map[string]any{"name": "nb-1", "namespace": "team-a"},
+		},
+	}
+
+	nb2 := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Notebook.APIVersion(),
+			"kind":       resources.Notebook.Kind,
+			"metadata":   map[string]any{"name": "nb-2", "namespace": "team-b"},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, notebookListKinds, nb1, nb2)
+	metadataClient := metadatafake.NewSimpleMetadataClient(scheme, kube.ToPartialObjectMetadata(nb1, nb2)...)
+
+	scope, err := check.NewNamespaceScope([]string{"team-a"}, "", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	target := check.Target{
+		Client: client.NewForTesting(client.TestClientConfig{
+			Dynamic:  dynamicClient,
+			Metadata: metadataClient,
+		}),
+		Namespaces: scope,
+	}
+
+	dr, err := validate.WorkloadsMetadata(newWorkloadTestCheck(), target, resources.Notebook).
+		Run(ctx, func(_ context.Context, req *validate.WorkloadRequest[*metav1.PartialObjectMetadata]) error {
+			g.Expect(req.Items).To(HaveLen(1))
+
+			return nil
+		})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.Annotations).To(HaveKeyWithValue(check.AnnotationImpactedWorkloadCount, "1"))
+	g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+	g.Expect(dr.ImpactedObjects[0].Namespace).To(Equal("team-a"))
+}
+
+func TestWorkloadBuilder_NamespaceScope_ListsPerNamespace(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	nb1 := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Notebook.APIVersion(),
+			"kind":       resources.Notebook.Kind,
+			"metadata":   map[string]any{"name": "nb-1", "namespace": "team-a"},
+		},
+	}
+
+	nb2 := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Notebook.APIVersion(),
+			"kind":       resources.Notebook.Kind,
+			"metadata":   map[string]any{"name": "nb-2", "namespace": "team-b"},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, notebookListKinds, nb1, nb2)
+	metadataClient := metadatafake.NewSimpleMetadataClient(scheme, kube.ToPartialObjectMetadata(nb1, nb2)...)
+
+	// Tenant-scoped credentials cannot list cluster-wide.
+	var listed []string
+
+	metadataClient.PrependReactor("list", "*", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "" {
+			return true, nil, apierrors.NewForbidden(resources.Notebook.GVR().GroupResource(), "", errors.New("cluster-wide list"))
+		}
+
+		listed = append(listed, action.GetNamespace())
+
+		return false, nil, nil
+	})
+
+	scope, err := check.NewNamespaceScope([]string{"team-a", "team-c", "team-d"}, "", []string{"team-d"})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	target := check.Target{
+		Client: client.NewForTesting(client.TestClientConfig{
+			Dynamic:  dynamicClient,
+			Metadata: metadataClient,
+		}),
+		Namespaces: scope,
+	}
+
+	dr, err := validate.WorkloadsMetadata(newWorkloadTestCheck(), target, resources.Notebook).
+		Run(ctx, func(_ context.Context, _ *validate.WorkloadRequest[*metav1.PartialObjectMetadata]) error {
+			return nil
+		})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(listed).To(Equal([]string{"team-a", "team-c"}))
+	g.Expect(dr.ImpactedObjects).To(HaveExactElements(HaveField("Name", "nb-1")))
+}
+
 func TestWorkloadBuilder_FullObjectListing_WithFilter(t *testing.T) {
 	g := NewWithT(t)
 	ctx := t.Context()
@@ -30,6 +30,10 @@ func NewAcceleratorProfileMigrationCheck() *AcceleratorProfileMigrationCheck {
 			CheckName:        "Components :: Dashboard :: AcceleratorProfile Migration (3.x)",
 			CheckDescription: "Lists deprecated AcceleratorProfiles that will be auto-migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade",
 			CheckRemediation: "Deprecated AcceleratorProfiles will be automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade - no manual action required",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonNoMigrationRequired},
+				{Reason: check.ReasonMigrationPending, Impact: result.ImpactAdvisory},
+			},
 		},
 	}
 }
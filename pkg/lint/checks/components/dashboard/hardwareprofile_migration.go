@@ -32,6 +32,10 @@ func NewHardwareProfileMigrationCheck() *HardwareProfileMigrationCheck {
 			CheckName:        "Components :: Dashboard :: HardwareProfile Migration (3.x)",
 			CheckDescription: "Lists legacy HardwareProfiles (opendatahub.io) that will be auto-migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade",
 			CheckRemediation: "Legacy HardwareProfiles will be automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade - no manual action required",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonNoMigrationRequired},
+				{Reason: check.ReasonMigrationPending, Impact: result.ImpactAdvisory},
+			},
 		},
 	}
 }
@@ -34,6 +34,10 @@ func NewRenamingCheck() *RenamingCheck {
 			CheckName:        "Components :: DataSciencePipelines :: Component Renaming (3.x)",
 			CheckDescription: "Informs about DataSciencePipelines component renaming to AIPipelines in DSC v2 (RHOAI 3.x)",
 			CheckRemediation: "No action required - the component will be automatically renamed. Update any automation referencing '.spec.components.datasciencepipelines' to use '.spec.components.aipipelines' after upgrade",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonResourceNotFound, Impact: result.ImpactAdvisory},
+				{Reason: check.ReasonComponentRenamed, Impact: result.ImpactAdvisory},
+			},
 		},
 	}
 }
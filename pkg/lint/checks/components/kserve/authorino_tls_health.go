@@ -0,0 +1,47 @@
+package kserve
+
+import (
+	"context"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+// AuthorinoTLSHealthCheck validates that Authorino is configured with TLS and ready on an
+// installed 3.x cluster. It reuses the AuthorinoTLSReadinessCheck validation in lint mode.
+// Only applies when llm-d workloads (LLMInferenceService) are detected.
+type AuthorinoTLSHealthCheck struct {
+	AuthorinoTLSReadinessCheck
+}
+
+func NewAuthorinoTLSHealthCheck() *AuthorinoTLSHealthCheck {
+	return &AuthorinoTLSHealthCheck{
+		AuthorinoTLSReadinessCheck: AuthorinoTLSReadinessCheck{
+			BaseCheck: check.BaseCheck{
+				CheckGroup:       check.GroupComponent,
+				Kind:             constants.ComponentKServe,
+				Type:             "authorino-tls-health",
+				CheckID:          "components.kserve.authorino-tls-health",
+				CheckName:        "Components :: KServe :: Authorino TLS Health",
+				CheckDescription: "Validates that Authorino used by llm-d is configured with TLS and ready",
+				CheckConditions: []check.ConditionSpec{
+					{Reason: check.ReasonConfigurationValid},
+					{Reason: check.ReasonResourceAvailable},
+					{Reason: check.ReasonResourceNotFound, Impact: result.ImpactBlocking},
+					{Reason: check.ReasonResourceUnavailable, Impact: result.ImpactBlocking},
+					{Reason: check.ReasonConfigurationInvalid, Impact: result.ImpactBlocking},
+					{Reason: check.ReasonInsufficientData, Impact: result.ImpactBlocking},
+				},
+			},
+		},
+	}
+}
+
+func (c *AuthorinoTLSHealthCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
+	if !isHealthCheck3x(target) {
+		return false, nil
+	}
+
+	return hasLLMInferenceServices(ctx, target)
+}
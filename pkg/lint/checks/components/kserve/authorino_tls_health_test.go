@@ -0,0 +1,85 @@
+package kserve_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/kserve"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+func TestAuthorinoTLSHealthCheck_TLSDisabled(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: authorinoListKinds(),
+		Objects: []*unstructured.Unstructured{
+			newLLMInferenceService(),
+			newAuthorino(false, "", "True"),
+		},
+	})
+
+	c := kserve.NewAuthorinoTLSHealthCheck()
+	result, err := c.Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Name).To(Equal("authorino-tls-health"))
+	g.Expect(result.Status.Conditions).To(HaveLen(2))
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":    Equal(check.ConditionTypeConfigured),
+		"Status":  Equal(metav1.ConditionFalse),
+		"Message": ContainSubstring("TLS is not enabled"),
+	}))
+	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+}
+
+func TestAuthorinoTLSHealthCheck_CanApply(t *testing.T) {
+	tests := []struct {
+		name           string
+		currentVersion string
+		targetVersion  string
+		objects        []*unstructured.Unstructured
+		want           bool
+	}{
+		{"installed 3.x with llm-d", "3.3.0", "3.3.0", []*unstructured.Unstructured{newLLMInferenceService()}, true},
+		{"installed 3.x without llm-d", "3.3.0", "3.3.0", nil, false},
+		{"installed 2.x", "2.25.0", "2.25.0", []*unstructured.Unstructured{newLLMInferenceService()}, false},
+		{"upgrade 2.x to 3.x", "2.25.0", "3.0.0", []*unstructured.Unstructured{newLLMInferenceService()}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			target := testutil.NewTarget(t, testutil.TargetConfig{
+				CurrentVersion: tt.currentVersion,
+				TargetVersion:  tt.targetVersion,
+				ListKinds:      authorinoListKinds(),
+				Objects:        tt.objects,
+			})
+
+			c := kserve.NewAuthorinoTLSHealthCheck()
+			canApply, err := c.CanApply(t.Context(), target)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(canApply).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestAuthorinoTLSHealthCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	c := kserve.NewAuthorinoTLSHealthCheck()
+
+	g.Expect(c.ID()).To(Equal("components.kserve.authorino-tls-health"))
+	g.Expect(c.Name()).To(Equal("Components :: KServe :: Authorino TLS Health"))
+	g.Expect(c.Group()).To(Equal(check.GroupComponent))
+	g.Expect(c.Description()).ToNot(BeEmpty())
+}
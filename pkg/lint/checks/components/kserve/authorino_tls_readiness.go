@@ -33,6 +33,14 @@ func NewAuthorinoTLSReadinessCheck() *AuthorinoTLSReadinessCheck {
 			CheckID:          "components.kserve.authorino-tls-readiness",
 			CheckName:        "Components :: KServe :: Authorino TLS Readiness",
 			CheckDescription: "Validates that Authorino is configured with TLS and ready (required for llm-d)",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonConfigurationValid},
+				{Reason: check.ReasonResourceAvailable},
+				{Reason: check.ReasonResourceNotFound, Impact: result.ImpactBlocking},
+				{Reason: check.ReasonResourceUnavailable, Impact: result.ImpactBlocking},
+				{Reason: check.ReasonConfigurationInvalid, Impact: result.ImpactBlocking},
+				{Reason: check.ReasonInsufficientData, Impact: result.ImpactBlocking},
+			},
 		},
 	}
 }
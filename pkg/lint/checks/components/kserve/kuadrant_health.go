@@ -0,0 +1,45 @@
+package kserve
+
+import (
+	"context"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+// KuadrantHealthCheck validates that the Kuadrant resource is present and ready on an
+// installed 3.x cluster. It reuses the KuadrantReadinessCheck validation in lint mode.
+// Only applies when llm-d workloads (LLMInferenceService) are detected.
+type KuadrantHealthCheck struct {
+	KuadrantReadinessCheck
+}
+
+func NewKuadrantHealthCheck() *KuadrantHealthCheck {
+	return &KuadrantHealthCheck{
+		KuadrantReadinessCheck: KuadrantReadinessCheck{
+			BaseCheck: check.BaseCheck{
+				CheckGroup:       check.GroupComponent,
+				Kind:             constants.ComponentKServe,
+				Type:             "kuadrant-health",
+				CheckID:          "components.kserve.kuadrant-health",
+				CheckName:        "Components :: KServe :: Kuadrant Health",
+				CheckDescription: "Validates that the Kuadrant resource used by llm-d is present and ready",
+				CheckConditions: []check.ConditionSpec{
+					{Reason: check.ReasonResourceAvailable},
+					{Reason: check.ReasonResourceNotFound, Impact: result.ImpactBlocking},
+					{Reason: check.ReasonResourceUnavailable, Impact: result.ImpactBlocking},
+					{Reason: check.ReasonInsufficientData, Impact: result.ImpactBlocking},
+				},
+			},
+		},
+	}
+}
+
+func (c *KuadrantHealthCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
+	if !isHealthCheck3x(target) {
+		return false, nil
+	}
+
+	return hasLLMInferenceServices(ctx, target)
+}
@@ -0,0 +1,85 @@
+package kserve_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/kserve"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+func TestKuadrantHealthCheck_NotReady(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: llmdListKinds(),
+		Objects: []*unstructured.Unstructured{
+			newLLMInferenceService(),
+			newKuadrant("False"),
+		},
+	})
+
+	c := kserve.NewKuadrantHealthCheck()
+	result, err := c.Validate(t.Context(), target)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Name).To(Equal("kuadrant-health"))
+	g.Expect(result.Status.Conditions).To(HaveLen(1))
+	g.Expect(result.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+		"Type":   Equal(check.ConditionTypeReady),
+		"Status": Equal(metav1.ConditionFalse),
+		"Reason": Equal(check.ReasonResourceUnavailable),
+	}))
+	g.Expect(result.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+}
+
+func TestKuadrantHealthCheck_CanApply(t *testing.T) {
+	tests := []struct {
+		name           string
+		currentVersion string
+		targetVersion  string
+		objects        []*unstructured.Unstructured
+		want           bool
+	}{
+		{"installed 3.x with llm-d", "3.3.1", "3.3.0", []*unstructured.Unstructured{newLLMInferenceService()}, true},
+		{"installed 3.x without llm-d", "3.3.0", "3.3.0", nil, false},
+		{"installed 2.x", "2.25.0", "2.25.0", []*unstructured.Unstructured{newLLMInferenceService()}, false},
+		{"upgrade 3.x to 3.y", "3.0.0", "3.3.0", []*unstructured.Unstructured{newLLMInferenceService()}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			target := testutil.NewTarget(t, testutil.TargetConfig{
+				CurrentVersion: tt.currentVersion,
+				TargetVersion:  tt.targetVersion,
+				ListKinds:      llmdListKinds(),
+				Objects:        tt.objects,
+			})
+
+			c := kserve.NewKuadrantHealthCheck()
+			canApply, err := c.CanApply(t.Context(), target)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(canApply).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestKuadrantHealthCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	c := kserve.NewKuadrantHealthCheck()
+
+	g.Expect(c.ID()).To(Equal("components.kserve.kuadrant-health"))
+	g.Expect(c.Name()).To(Equal("Components :: KServe :: Kuadrant Health"))
+	g.Expect(c.Group()).To(Equal(check.GroupComponent))
+	g.Expect(c.Description()).ToNot(BeEmpty())
+}
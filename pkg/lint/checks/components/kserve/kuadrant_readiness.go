@@ -30,6 +30,12 @@ func NewKuadrantReadinessCheck() *KuadrantReadinessCheck {
 			CheckID:          "components.kserve.kuadrant-readiness",
 			CheckName:        "Components :: KServe :: Kuadrant Readiness",
 			CheckDescription: "Validates that the Kuadrant resource is present and ready (required for llm-d)",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonResourceAvailable},
+				{Reason: check.ReasonResourceNotFound, Impact: result.ImpactBlocking},
+				{Reason: check.ReasonResourceUnavailable, Impact: result.ImpactBlocking},
+				{Reason: check.ReasonInsufficientData, Impact: result.ImpactBlocking},
+			},
 		},
 	}
 }
@@ -34,6 +34,11 @@ func NewServerlessRemovalCheck() *ServerlessRemovalCheck {
 			CheckName:        "Components :: KServe :: Serverless Removal (3.x)",
 			CheckDescription: "Validates that KServe serverless mode is disabled before upgrading from RHOAI 2.x to 3.x (serverless support will be removed)",
 			CheckRemediation: "Disable KServe serverless mode by setting serving.managementState to 'Removed' in DataScienceCluster before upgrading",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonResourceNotFound, Impact: result.ImpactAdvisory},
+				{Reason: check.ReasonVersionCompatible},
+				{Reason: check.ReasonVersionIncompatible, Impact: result.ImpactBlocking},
+			},
 		},
 	}
 }
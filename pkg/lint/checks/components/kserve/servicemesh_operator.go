@@ -27,6 +27,10 @@ func NewServiceMeshOperatorCheck() *ServiceMeshOperatorCheck {
 			CheckID:          "components.kserve.servicemesh-operator-upgrade",
 			CheckName:        "Components :: KServe :: ServiceMesh Operator Upgrade (3.x)",
 			CheckDescription: "Validates that Service Mesh Operator v2 is not installed when upgrading to RHOAI 3.x (no longer required, OpenShift 4.19+ handles service mesh internally)",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonVersionCompatible},
+				{Reason: check.ReasonVersionIncompatible, Impact: result.ImpactAdvisory},
+			},
 		},
 	}
 }
@@ -31,6 +31,11 @@ func NewServiceMeshRemovalCheck() *ServiceMeshRemovalCheck {
 			CheckName:        "Components :: KServe :: ServiceMesh Removal (3.x)",
 			CheckDescription: "Validates that ServiceMesh is disabled before upgrading from RHOAI 2.x to 3.x (no longer required, OpenShift 4.19+ handles service mesh internally)",
 			CheckRemediation: "Disable ServiceMesh by setting managementState to 'Removed' in DSCInitialization before upgrading",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonVersionCompatible},
+				{Reason: check.ReasonResourceNotFound, Impact: result.ImpactAdvisory},
+				{Reason: check.ReasonVersionIncompatible, Impact: result.ImpactBlocking},
+			},
 		},
 	}
 }
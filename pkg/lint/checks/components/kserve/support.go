@@ -13,6 +13,7 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
 const (
@@ -36,6 +37,13 @@ func hasLLMInferenceServices(ctx context.Context, target check.Target) (bool, er
 	return len(items) > 0, nil
 }
 
+// isHealthCheck3x returns true when lint validates an installed 3.x cluster rather than
+// assessing an upgrade (current and target share the same major.minor).
+func isHealthCheck3x(target check.Target) bool {
+	return version.SameMajorMinor(target.CurrentVersion, target.TargetVersion) &&
+		version.IsVersion3x(target.CurrentVersion)
+}
+
 // validateReadyCondition checks that the Ready condition is True on a resource.
 func validateReadyCondition(
 	dr *result.DiagnosticResult,
@@ -40,6 +40,10 @@ func NewManagementStateCheck() *ManagementStateCheck {
 			CheckID:          "components.kueue.management-state",
 			CheckName:        "Components :: Kueue :: Management State (3.x)",
 			CheckDescription: "Validates that Kueue managementState is Removed before upgrading to RHOAI 3.x",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonResourceNotFound, Impact: result.ImpactAdvisory},
+				{Reason: check.ReasonVersionIncompatible, Impact: result.ImpactProhibited},
+			},
 		},
 	}
 }
@@ -44,6 +44,12 @@ func NewOperatorInstalledCheck() *OperatorInstalledCheck {
 			CheckID:          "components.kueue.operator-installed",
 			CheckName:        "Components :: Kueue :: Operator Installed",
 			CheckDescription: "Validates Red Hat build of Kueue operator installation is consistent with Kueue management state",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonResourceNotFound, Impact: result.ImpactAdvisory},
+				{Reason: check.ReasonVersionCompatible},
+				{Reason: check.ReasonVersionIncompatible, Impact: result.ImpactBlocking},
+				{Reason: check.ReasonVersionIncompatible, Impact: result.ImpactProhibited},
+			},
 		},
 	}
 }
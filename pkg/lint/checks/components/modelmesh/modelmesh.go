@@ -30,6 +30,10 @@ func NewRemovalCheck() *RemovalCheck {
 			CheckName:        "Components :: ModelMesh Serving :: Removal (3.x)",
 			CheckDescription: "Validates that ModelMesh Serving is disabled before upgrading from RHOAI 2.x to 3.x (component will be removed)",
 			CheckRemediation: "Disable ModelMesh Serving by setting managementState to 'Removed' in DataScienceCluster before upgrading",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonResourceNotFound, Impact: result.ImpactAdvisory},
+				{Reason: check.ReasonVersionIncompatible, Impact: result.ImpactBlocking},
+			},
 		},
 	}
 }
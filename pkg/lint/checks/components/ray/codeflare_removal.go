@@ -36,6 +36,10 @@ func NewCodeFlareRemovalCheck() *CodeFlareRemovalCheck {
 			CheckName:        "Components :: Ray :: CodeFlare Removal (3.x)",
 			CheckDescription: "Validates that the CodeFlare security layer is disabled before upgrading from RHOAI 2.x to 3.x",
 			CheckRemediation: "Disable CodeFlare by setting managementState to 'Removed' in DataScienceCluster before upgrading",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonResourceNotFound, Impact: result.ImpactAdvisory},
+				{Reason: check.ReasonVersionIncompatible, Impact: result.ImpactBlocking},
+			},
 		},
 	}
 }
@@ -31,6 +31,10 @@ func NewDeprecationCheck() *DeprecationCheck {
 			CheckName:        "Components :: TrainingOperator :: Deprecation (3.3+)",
 			CheckDescription: "Validates that TrainingOperator (Kubeflow Training Operator v1) deprecation is acknowledged - will be replaced by Trainer v2 in future RHOAI releases",
 			CheckRemediation: "Plan migration from TrainingOperator (Kubeflow v1) to Trainer v2 in a future release",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonResourceNotFound, Impact: result.ImpactAdvisory},
+				{Reason: check.ReasonDeprecated, Impact: result.ImpactAdvisory},
+			},
 		},
 	}
 }
@@ -0,0 +1,148 @@
+package declarative
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver/v4"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+// Check is a check built from a Definition. It lists the objects of the definition's
+// resource type and reports those matching the jq predicate as impacted.
+type Check struct {
+	check.BaseCheck
+	check.EnhancedVerboseFormatter
+
+	resourceType resources.ResourceType
+	component    string
+	versions     semver.Range
+	fromVersions semver.Range
+	predicate    string
+	impact       result.Impact
+	message      string
+}
+
+// NewCheck validates def and returns the check it describes.
+func NewCheck(def Definition) (*Check, error) {
+	if err := def.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Validate has ensured the resource and ranges parse.
+	resourceType, _ := def.Resource.ResourceType()
+
+	checkType := def.Type
+	if checkType == "" {
+		checkType = check.CheckTypeImpactedWorkloads
+	}
+
+	message := def.Message
+	if message == "" {
+		message = "Found %d impacted " + resourceType.Kind + "(s)"
+	}
+
+	c := &Check{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       def.Group,
+			Kind:             def.Kind,
+			Type:             checkType,
+			CheckID:          def.ID,
+			CheckName:        def.Name,
+			CheckDescription: def.Description,
+			CheckRemediation: def.Remediation,
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonRequirementsMet},
+				{Reason: check.ReasonWorkloadsImpacted, Impact: def.Impact},
+			},
+		},
+		resourceType: resourceType,
+		component:    def.Component,
+		predicate:    def.Predicate,
+		impact:       def.Impact,
+		message:      message,
+	}
+
+	if def.Versions != "" {
+		c.versions, _ = semver.ParseRange(def.Versions)
+	}
+
+	if def.FromVersions != "" {
+		c.fromVersions, _ = semver.ParseRange(def.FromVersions)
+	}
+
+	return c, nil
+}
+
+// CanApply returns whether the target and current versions are in the definition's ranges.
+func (c *Check) CanApply(_ context.Context, target check.Target) (bool, error) {
+	return inRange(c.versions, target.TargetVersion) && inRange(c.fromVersions, target.CurrentVersion), nil
+}
+
+// Validate lists the objects of the resource type and reports those matching the predicate.
+func (c *Check) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	builder := validate.Workloads(c, target, c.resourceType).
+		Filter(jq.Predicate(c.predicate))
+
+	if c.component != "" {
+		builder = builder.ForComponent(c.component)
+	}
+
+	return builder.Complete(ctx, c.newCondition)
+}
+
+func (c *Check) newCondition(
+	_ context.Context,
+	req *validate.WorkloadRequest[*unstructured.Unstructured],
+) ([]result.Condition, error) {
+	count := len(req.Items)
+
+	if count == 0 {
+		return []result.Condition{
+			check.NewCondition(
+				check.ConditionTypeValidated,
+				metav1.ConditionTrue,
+				check.WithReason(check.ReasonRequirementsMet),
+				check.WithMessage("No impacted %s found", c.resourceType.Kind),
+			),
+		}, nil
+	}
+
+	return []result.Condition{
+		check.NewCondition(
+			check.ConditionTypeValidated,
+			metav1.ConditionFalse,
+			check.WithReason(check.ReasonWorkloadsImpacted),
+			check.WithMessage("%s", formatMessage(c.message, count)),
+			check.WithImpact(c.impact),
+			check.WithRemediation(c.CheckRemediation),
+		),
+	}, nil
+}
+
+// inRange returns true if r is unset, or v is set and satisfies r.
+func inRange(r semver.Range, v *semver.Version) bool {
+	if r == nil {
+		return true
+	}
+
+	return v != nil && r(*v)
+}
+
+// formatMessage substitutes count for the %d verb of message, if any.
+func formatMessage(message string, count int) string {
+	if !strings.Contains(message, "%d") {
+		return message
+	}
+
+	return fmt.Sprintf(message, count)
+}
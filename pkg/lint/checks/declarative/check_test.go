@@ -0,0 +1,267 @@
+package declarative_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/declarative"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+//nolint:gochecknoglobals
+var listKinds = map[schema.GroupVersionResource]string{
+	resources.Notebook.GVR():           resources.Notebook.ListKind(),
+	resources.InferenceService.GVR():   resources.InferenceService.ListKind(),
+	resources.DSCInitialization.GVR():  resources.DSCInitialization.ListKind(),
+	resources.DataScienceCluster.GVR(): resources.DataScienceCluster.ListKind(),
+}
+
+func imageDefinition() declarative.Definition {
+	return declarative.Definition{
+		ID:          "workloads.notebook.external-images",
+		Name:        "Workloads :: Notebook :: External Images",
+		Description: "Lists Notebooks using images outside the internal registry",
+		Group:       check.GroupWorkload,
+		Kind:        "notebook",
+		Resource: declarative.ResourceDefinition{
+			APIVersion: "kubeflow.org/v1",
+			Kind:       "Notebook",
+			Plural:     "notebooks",
+		},
+		Component:   "workbenches",
+		Versions:    ">=3.0.0",
+		Predicate:   `.spec.template.spec.containers | any(.image | startswith("registry.internal/") | not)`,
+		Impact:      resultpkg.ImpactBlocking,
+		Message:     "Found %d Notebook(s) using external images",
+		Remediation: "Rebuild the images in registry.internal",
+	}
+}
+
+func newNotebook(name string, image string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Notebook.APIVersion(),
+			"kind":       resources.Notebook.Kind,
+			"metadata":   map[string]any{"name": name, "namespace": "team-a"},
+			"spec": map[string]any{
+				"template": map[string]any{
+					"spec": map[string]any{
+						"containers": []any{map[string]any{"name": name, "image": image}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewCheck_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	chk, err := declarative.NewCheck(imageDefinition())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(chk.ID()).To(Equal("workloads.notebook.external-images"))
+	g.Expect(chk.Group()).To(Equal(check.GroupWorkload))
+	g.Expect(chk.CheckKind()).To(Equal("notebook"))
+	g.Expect(chk.CheckType()).To(Equal(string(check.CheckTypeImpactedWorkloads)))
+	g.Expect(chk.Remediation()).To(Equal("Rebuild the images in registry.internal"))
+	g.Expect(chk.Conditions()).To(Equal([]check.ConditionSpec{
+		{Reason: check.ReasonRequirementsMet},
+		{Reason: check.ReasonWorkloadsImpacted, Impact: resultpkg.ImpactBlocking},
+	}))
+}
+
+func TestDefinition_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(d *declarative.Definition)
+		wantErr string
+	}{
+		{"missing id", func(d *declarative.Definition) { d.ID = "" }, "id must not be empty"},
+		{"invalid group", func(d *declarative.Definition) { d.Group = "workloads" }, "invalid group"},
+		{"missing impact", func(d *declarative.Definition) { d.Impact = "" }, "impact must be one of"},
+		{"invalid impact", func(d *declarative.Definition) { d.Impact = "critical" }, "invalid impact"},
+		{"missing plural", func(d *declarative.Definition) { d.Resource.Plural = "" }, "requires apiVersion, kind and plural"},
+		{"invalid range", func(d *declarative.Definition) { d.Versions = "three" }, "invalid versions range"},
+		{"invalid predicate", func(d *declarative.Definition) { d.Predicate = ".spec.[" }, "invalid predicate"},
+		{"invalid message", func(d *declarative.Definition) { d.Message = "%d of %s" }, "invalid message"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			def := imageDefinition()
+			tt.mutate(&def)
+
+			_, err := declarative.NewCheck(def)
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestCheck_CanApply(t *testing.T) {
+	tests := []struct {
+		name           string
+		currentVersion string
+		targetVersion  string
+		fromVersions   string
+		want           bool
+	}{
+		{"target in range", "2.25.0", "3.3.0", "", true},
+		{"target below range", "2.17.0", "2.25.0", "", false},
+		{"current in from range", "2.25.0", "3.3.0", ">=2.25.0 <3.0.0", true},
+		{"current outside from range", "3.0.0", "3.3.0", ">=2.25.0 <3.0.0", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			def := imageDefinition()
+			def.FromVersions = tt.fromVersions
+
+			chk, err := declarative.NewCheck(def)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			target := testutil.NewTarget(t, testutil.TargetConfig{
+				ListKinds:      listKinds,
+				CurrentVersion: tt.currentVersion,
+				TargetVersion:  tt.targetVersion,
+			})
+
+			canApply, err := chk.CanApply(t.Context(), target)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(canApply).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestCheck_Validate(t *testing.T) {
+	chk, err := declarative.NewCheck(imageDefinition())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("should report objects matching the predicate", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				testutil.NewDSC(map[string]string{"workbenches": "Managed"}),
+				newNotebook("internal", "registry.internal/datascience:1.0"),
+				newNotebook("external", "quay.io/someone/notebook:latest"),
+			},
+			CurrentVersion: "2.25.0",
+			TargetVersion:  "3.3.0",
+		})
+
+		dr, err := chk.Validate(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(dr.Status.Conditions).To(HaveLen(1))
+		g.Expect(dr.Status.Conditions[0].Condition).To(MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(check.ConditionTypeValidated),
+			"Status":  Equal(metav1.ConditionFalse),
+			"Reason":  Equal(check.ReasonWorkloadsImpacted),
+			"Message": Equal("Found 1 Notebook(s) using external images"),
+		}))
+		g.Expect(dr.Status.Conditions[0].Impact).To(Equal(resultpkg.ImpactBlocking))
+		g.Expect(dr.ImpactedObjects).To(HaveLen(1))
+		g.Expect(dr.ImpactedObjects[0].Name).To(Equal("external"))
+	})
+
+	t.Run("should pass when no object matches", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				testutil.NewDSC(map[string]string{"workbenches": "Managed"}),
+				newNotebook("internal", "registry.internal/datascience:1.0"),
+			},
+			CurrentVersion: "2.25.0",
+			TargetVersion:  "3.3.0",
+		})
+
+		dr, err := chk.Validate(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(dr.Status.Conditions[0].Status).To(Equal(metav1.ConditionTrue))
+		g.Expect(dr.Status.Conditions[0].Message).To(Equal("No impacted Notebook found"))
+	})
+
+	t.Run("should be skipped when the component is removed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds: listKinds,
+			Objects: []*unstructured.Unstructured{
+				testutil.NewDSC(map[string]string{"workbenches": "Removed"}),
+				newNotebook("external", "quay.io/someone/notebook:latest"),
+			},
+			CurrentVersion: "2.25.0",
+			TargetVersion:  "3.3.0",
+		})
+
+		dr, err := chk.Validate(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(dr).To(BeNil())
+	})
+}
+
+func TestLoadDir(t *testing.T) {
+	t.Run("should load every YAML definition", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(dir, "external-images.yaml"), []byte(`
+id: workloads.notebook.external-images
+name: "Workloads :: Notebook :: External Images"
+description: Lists Notebooks using images outside the internal registry
+group: workload
+kind: notebook
+resource:
+  apiVersion: kubeflow.org/v1
+  kind: Notebook
+  plural: notebooks
+predicate: '.metadata.name == "external"'
+impact: advisory
+remediation: Rebuild the images in registry.internal
+`), 0o600)).To(Succeed())
+		g.Expect(os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a check"), 0o600)).To(Succeed())
+
+		checks, err := declarative.LoadDir(dir)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(checks).To(HaveLen(1))
+		g.Expect(checks[0].ID()).To(Equal("workloads.notebook.external-images"))
+	})
+
+	t.Run("should reject unknown fields", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(dir, "bad.yaml"), []byte("id: x\nseverity: high\n"), 0o600)).To(Succeed())
+
+		_, err := declarative.LoadDir(dir)
+		g.Expect(err).To(MatchError(ContainSubstring("parsing check definition bad.yaml")))
+	})
+}
+
+func TestLoadEmbedded(t *testing.T) {
+	g := NewWithT(t)
+
+	checks, err := declarative.LoadEmbedded()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(checks).ToNot(BeEmpty())
+}
@@ -0,0 +1,209 @@
+package declarative
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+// Definition describes a check whose impacted objects are the objects of one resource type
+// matching a jq predicate. It is read from a YAML file, one definition per file.
+type Definition struct {
+	// ID is the unique check ID, e.g. "workloads.notebook.custom-images".
+	ID string `json:"id"`
+
+	// Name is the human-readable check name.
+	Name string `json:"name"`
+
+	// Description explains what the check validates.
+	Description string `json:"description"`
+
+	// Group is the check group (component, dependency, platform, service, workload).
+	Group check.CheckGroup `json:"group"`
+
+	// Kind is the kind of the checked area, e.g. "notebook" or "kserve".
+	Kind string `json:"kind"`
+
+	// Type is the type of check; defaults to "impacted-workloads".
+	Type check.CheckType `json:"type,omitempty"`
+
+	// Resource is the resource type whose objects are evaluated.
+	Resource ResourceDefinition `json:"resource"`
+
+	// Component optionally names the DSC component the check belongs to. The check is
+	// skipped when the component is Removed or no DataScienceCluster exists.
+	Component string `json:"component,omitempty"`
+
+	// Versions is an optional semver range the target version must satisfy,
+	// e.g. ">=3.0.0" or ">=2.25.0 <3.0.0". The check applies to every version when empty.
+	Versions string `json:"versions,omitempty"`
+
+	// FromVersions is an optional semver range the current version must satisfy.
+	FromVersions string `json:"fromVersions,omitempty"`
+
+	// Predicate is a jq expression evaluated against each object; objects for which it
+	// returns true are impacted.
+	Predicate string `json:"predicate"`
+
+	// Impact is the impact reported when objects are impacted (prohibited, blocking, advisory).
+	Impact result.Impact `json:"impact"`
+
+	// Message is the failure message; a %d verb is replaced with the number of impacted objects.
+	// Defaults to "Found %d impacted <Kind>(s)".
+	Message string `json:"message,omitempty"`
+
+	// Remediation is the guidance shown for impacted objects.
+	Remediation string `json:"remediation"`
+}
+
+// ResourceDefinition identifies a Kubernetes resource type.
+type ResourceDefinition struct {
+	// APIVersion is the group/version of the resource, e.g. "kubeflow.org/v1".
+	APIVersion string `json:"apiVersion"`
+
+	// Kind is the object kind, e.g. "Notebook".
+	Kind string `json:"kind"`
+
+	// Plural is the resource name used in API paths, e.g. "notebooks".
+	Plural string `json:"plural"`
+}
+
+// ResourceType returns the resources.ResourceType of r.
+func (r ResourceDefinition) ResourceType() (resources.ResourceType, error) {
+	gv, err := schema.ParseGroupVersion(r.APIVersion)
+	if err != nil {
+		return resources.ResourceType{}, fmt.Errorf("invalid apiVersion %q: %w", r.APIVersion, err)
+	}
+
+	if gv.Version == "" || r.Kind == "" || r.Plural == "" {
+		return resources.ResourceType{}, errors.New("resource requires apiVersion, kind and plural")
+	}
+
+	return resources.ResourceType{
+		Group:    gv.Group,
+		Version:  gv.Version,
+		Kind:     r.Kind,
+		Resource: r.Plural,
+	}, nil
+}
+
+// Validate checks that the definition is complete and its expressions parse.
+func (d *Definition) Validate() error {
+	required := []struct {
+		field string
+		value string
+	}{
+		{"id", d.ID},
+		{"name", d.Name},
+		{"description", d.Description},
+		{"kind", d.Kind},
+		{"predicate", d.Predicate},
+		{"remediation", d.Remediation},
+	}
+
+	for _, r := range required {
+		if strings.TrimSpace(r.value) == "" {
+			return fmt.Errorf("%s must not be empty", r.field)
+		}
+	}
+
+	if !slices.Contains(check.CanonicalGroupOrder, d.Group) {
+		return fmt.Errorf("invalid group %q (must be one of: component, dependency, platform, service, workload)", d.Group)
+	}
+
+	switch d.Impact {
+	case result.ImpactProhibited, result.ImpactBlocking, result.ImpactAdvisory:
+	case result.ImpactNone:
+		return errors.New("impact must be one of: prohibited, blocking, advisory")
+	default:
+		return fmt.Errorf("invalid impact %q (must be one of: prohibited, blocking, advisory)", d.Impact)
+	}
+
+	if _, err := d.Resource.ResourceType(); err != nil {
+		return err
+	}
+
+	ranges := []struct {
+		field string
+		value string
+	}{
+		{"versions", d.Versions},
+		{"fromVersions", d.FromVersions},
+	}
+
+	for _, r := range ranges {
+		if r.value == "" {
+			continue
+		}
+
+		if _, err := semver.ParseRange(r.value); err != nil {
+			return fmt.Errorf("invalid %s range %q: %w", r.field, r.value, err)
+		}
+	}
+
+	if err := jq.Validate(d.Predicate); err != nil {
+		return fmt.Errorf("invalid predicate: %w", err)
+	}
+
+	if strings.Contains(formatMessage(d.Message, 0), "%!") {
+		return fmt.Errorf("invalid message %q: only a single %%d verb is supported", d.Message)
+	}
+
+	return nil
+}
+
+// LoadDir reads every .yaml and .yml file of dir as a check definition.
+func LoadDir(dir string) ([]*Check, error) {
+	return load(os.DirFS(dir), ".", dir)
+}
+
+// load parses the definitions in dir of fsys, sorted by file name. source names the
+// location in error messages.
+func load(fsys fs.FS, dir string, source string) ([]*Check, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading check directory %s: %w", source, err)
+	}
+
+	var checks []*Check
+
+	for _, entry := range entries {
+		ext := path.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+
+		data, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("reading check definition %s: %w", entry.Name(), err)
+		}
+
+		var def Definition
+		if err := yaml.UnmarshalStrict(data, &def); err != nil {
+			return nil, fmt.Errorf("parsing check definition %s: %w", entry.Name(), err)
+		}
+
+		c, err := NewCheck(def)
+		if err != nil {
+			return nil, fmt.Errorf("check definition %s: %w", entry.Name(), err)
+		}
+
+		checks = append(checks, c)
+	}
+
+	return checks, nil
+}
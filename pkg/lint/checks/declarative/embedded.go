@@ -0,0 +1,28 @@
+package declarative
+
+import (
+	"embed"
+	"fmt"
+)
+
+// definitions holds the check definitions shipped with the CLI.
+//
+//go:embed definitions/*.yaml
+//nolint:gochecknoglobals // go:embed requires a package-level variable
+var definitions embed.FS
+
+// LoadEmbedded returns the checks of the definitions shipped with the CLI.
+func LoadEmbedded() ([]*Check, error) {
+	return load(definitions, "definitions", "embedded definitions")
+}
+
+// MustLoadEmbedded returns the embedded checks and panics if a definition is invalid.
+// The definitions are part of the binary, so an invalid one is a build defect.
+func MustLoadEmbedded() []*Check {
+	checks, err := LoadEmbedded()
+	if err != nil {
+		panic(fmt.Sprintf("loading embedded check definitions: %v", err))
+	}
+
+	return checks
+}
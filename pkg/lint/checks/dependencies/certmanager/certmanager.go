@@ -28,6 +28,10 @@ func NewCheck() *Check {
 			CheckID:          "dependencies.certmanager.installed",
 			CheckName:        "Dependencies :: cert-manager :: Installed",
 			CheckDescription: "Reports the cert-manager operator installation status and version",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonResourceFound},
+				{Reason: check.ReasonResourceNotFound, Impact: result.ImpactBlocking},
+			},
 		},
 	}
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/upgrade"
 	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
@@ -17,12 +18,18 @@ const (
 	checkType = "version-requirement"
 )
 
+// defaultMinVersion is the minimum OpenShift version of RHOAI 3.0, used for target
+// versions older than every release of the upgrade graph.
+//
 //nolint:gochecknoglobals
-var minVersion = semver.MustParse("4.19.9")
+var defaultMinVersion = semver.MustParse("4.19.9")
 
-// Check validates OpenShift version requirements for RHOAI 3.x upgrades.
+// Check validates OpenShift version requirements for RHOAI 3.x upgrades. The minimum
+// OpenShift version of each release is read from the bundled upgrade graph.
 type Check struct {
 	check.BaseCheck
+
+	graph *upgrade.Graph
 }
 
 // NewCheck creates a new OpenShift version requirement check.
@@ -34,8 +41,14 @@ func NewCheck() *Check {
 			Type:             checkType,
 			CheckID:          "dependencies.openshift.version-requirement",
 			CheckName:        "Dependencies :: OpenShift :: Version Requirement (3.x)",
-			CheckDescription: "Validates that OpenShift is at least version 4.19.9 when upgrading to RHOAI 3.x",
+			CheckDescription: "Validates that OpenShift meets the minimum version of the target RHOAI 3.x release",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonVersionCompatible},
+				{Reason: check.ReasonVersionIncompatible, Impact: result.ImpactBlocking},
+				{Reason: check.ReasonInsufficientData, Impact: result.ImpactBlocking},
+			},
 		},
+		graph: upgrade.Default(),
 	}
 }
 
@@ -49,6 +62,7 @@ func (c *Check) Validate(
 ) (*result.DiagnosticResult, error) {
 	dr := c.NewResult()
 	tv := version.MajorMinorLabel(target.TargetVersion)
+	minVersion := c.minVersion(target.TargetVersion)
 
 	ver, err := version.DetectOpenShiftVersion(ctx, target.Client)
 
@@ -81,3 +95,16 @@ func (c *Check) Validate(
 
 	return dr, nil
 }
+
+// minVersion returns the minimum OpenShift version of the target release.
+func (c *Check) minVersion(target *semver.Version) semver.Version {
+	if target == nil {
+		return defaultMinVersion
+	}
+
+	if v, ok := c.graph.MinOpenShiftVersion(*target); ok {
+		return v
+	}
+
+	return defaultMinVersion
+}
@@ -27,6 +27,12 @@ func NewDataScienceClusterReadinessCheck() *DataScienceClusterReadinessCheck {
 			CheckID:          "platform.dsc.readiness",
 			CheckName:        "Platform :: DSC :: Readiness Check",
 			CheckDescription: "Validates that DataScienceCluster is in Ready state",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonResourceAvailable},
+				{Reason: check.ReasonResourceNotFound, Impact: result.ImpactAdvisory},
+				{Reason: check.ReasonResourceUnavailable, Impact: result.ImpactBlocking},
+				{Reason: check.ReasonInsufficientData, Impact: result.ImpactBlocking},
+			},
 		},
 	}
 }
@@ -25,6 +25,12 @@ func NewDSCInitializationReadinessCheck() *DSCInitializationReadinessCheck {
 			CheckID:          "platform.dsci.readiness",
 			CheckName:        "Platform :: DSCI :: Readiness Check",
 			CheckDescription: "Validates that DSCInitialization is in Ready state before upgrading to RHOAI 3.x",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonResourceAvailable},
+				{Reason: check.ReasonResourceNotFound, Impact: result.ImpactAdvisory},
+				{Reason: check.ReasonResourceUnavailable, Impact: result.ImpactBlocking},
+				{Reason: check.ReasonInsufficientData, Impact: result.ImpactBlocking},
+			},
 		},
 	}
 }
@@ -0,0 +1,140 @@
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+)
+
+// Prefix is the file name prefix of check plugin executables. The rest of the name is
+// the check ID, e.g. odh-lint-check-workloads.acme.quota.
+const Prefix = "odh-lint-check-"
+
+// minIDSegments is the number of dot-separated segments of a plugin check ID:
+// category, kind and type.
+const minIDSegments = 3
+
+// Discover finds the check plugins in the directories of pathList (formatted like $PATH).
+// As with kubectl plugins, when several directories contain the same plugin the first one
+// wins. Files that are not executable or whose name is not a valid check ID are skipped
+// and reported as warnings.
+func Discover(pathList string) ([]*Check, []string) {
+	var (
+		checks   []*Check
+		warnings []string
+	)
+
+	seen := make(map[string]string)
+
+	for _, dir := range filepath.SplitList(pathList) {
+		if dir == "" {
+			continue
+		}
+
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasPrefix(entry.Name(), Prefix) {
+				continue
+			}
+
+			path := filepath.Join(dir, entry.Name())
+
+			if !isExecutable(path) {
+				warnings = append(warnings, fmt.Sprintf("%s is not executable and was ignored", path))
+
+				continue
+			}
+
+			id := strings.TrimPrefix(entry.Name(), Prefix)
+			if runtime.GOOS == "windows" {
+				id = strings.TrimSuffix(id, filepath.Ext(id))
+			}
+
+			if first, ok := seen[id]; ok {
+				warnings = append(warnings, fmt.Sprintf("%s is shadowed by %s and was ignored", path, first))
+
+				continue
+			}
+
+			c, err := NewCheck(id, path)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("%s was ignored: %v", path, err))
+
+				continue
+			}
+
+			seen[id] = path
+			checks = append(checks, c)
+		}
+	}
+
+	return checks, warnings
+}
+
+// NewCheck returns the plugin check for the executable at path. The check ID must have the
+// form <category>.<kind>.<type>, where category is one of the --checks group shortcuts
+// (components, dependencies, platform, services, workloads).
+func NewCheck(id string, path string) (*Check, error) {
+	segments := strings.SplitN(id, ".", minIDSegments)
+	if len(segments) < minIDSegments || segments[1] == "" || segments[2] == "" {
+		return nil, fmt.Errorf("invalid check ID %q: must be <category>.<kind>.<type>", id)
+	}
+
+	group, err := groupForCategory(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid check ID %q: %w", id, err)
+	}
+
+	return &Check{
+		BaseCheck: check.BaseCheck{
+			CheckGroup:       group,
+			Kind:             segments[1],
+			Type:             check.CheckType(segments[2]),
+			CheckID:          id,
+			CheckName:        "Plugin :: " + id,
+			CheckDescription: "External check plugin " + path,
+		},
+		Path:    path,
+		Timeout: DefaultTimeout,
+	}, nil
+}
+
+// groupForCategory maps a check ID category to its check group.
+func groupForCategory(category string) (check.CheckGroup, error) {
+	switch category {
+	case check.SelectorComponents:
+		return check.GroupComponent, nil
+	case check.SelectorDependencies:
+		return check.GroupDependency, nil
+	case check.SelectorPlatform:
+		return check.GroupPlatform, nil
+	case check.SelectorServices:
+		return check.GroupService, nil
+	case check.SelectorWorkloads:
+		return check.GroupWorkload, nil
+	}
+
+	return "", fmt.Errorf("unknown category %q (must be one of: components, dependencies, platform, services, workloads)", category)
+}
+
+// isExecutable reports whether path is a regular file the user can execute.
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || !info.Mode().IsRegular() {
+		return false
+	}
+
+	if runtime.GOOS == "windows" {
+		return strings.EqualFold(filepath.Ext(path), ".exe")
+	}
+
+	return info.Mode().Perm()&0o111 != 0
+}
@@ -0,0 +1,59 @@
+package plugin_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/plugin"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDiscover(t *testing.T) {
+	g := NewWithT(t)
+
+	first := t.TempDir()
+	second := t.TempDir()
+
+	quota := writePlugin(t, first, "workloads.acme.quota", "exit 0")
+	writePlugin(t, second, "workloads.acme.quota", "exit 0")
+	writePlugin(t, second, "platform.acme.license", "exit 0")
+	writePlugin(t, second, "acme.quota", "exit 0")
+	writePlugin(t, second, "network.acme.policy", "exit 0")
+	g.Expect(os.WriteFile(filepath.Join(second, plugin.Prefix+"dependencies.acme.docs"), nil, 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(second, "kubectl-acme"), nil, 0o700)).To(Succeed())
+
+	pathList := strings.Join([]string{first, filepath.Join(first, "missing"), second}, string(os.PathListSeparator))
+
+	checks, warnings := plugin.Discover(pathList)
+
+	ids := make([]string, 0, len(checks))
+	for _, c := range checks {
+		ids = append(ids, c.ID())
+	}
+
+	g.Expect(ids).To(ConsistOf("workloads.acme.quota", "platform.acme.license"))
+	g.Expect(checks[0].Path).To(Equal(quota))
+	g.Expect(warnings).To(ConsistOf(
+		ContainSubstring("is shadowed by "+quota),
+		ContainSubstring("must be <category>.<kind>.<type>"),
+		ContainSubstring(`unknown category "network"`),
+		ContainSubstring("is not executable"),
+	))
+}
+
+func TestNewCheck(t *testing.T) {
+	g := NewWithT(t)
+
+	chk, err := plugin.NewCheck("services.acme.auth-config", "/usr/local/bin/odh-lint-check-services.acme.auth-config")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(chk.ID()).To(Equal("services.acme.auth-config"))
+	g.Expect(chk.Group()).To(Equal(check.GroupService))
+	g.Expect(chk.CheckKind()).To(Equal("acme"))
+	g.Expect(chk.CheckType()).To(Equal("auth-config"))
+	g.Expect(chk.Timeout).To(Equal(plugin.DefaultTimeout))
+}
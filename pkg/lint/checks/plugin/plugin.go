@@ -0,0 +1,170 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+// DefaultTimeout bounds a single plugin execution.
+const DefaultTimeout = 2 * time.Minute
+
+// maxStderrLen limits how much plugin stderr is included in error messages.
+const maxStderrLen = 512
+
+// Request is the JSON document written to the plugin's stdin.
+type Request struct {
+	// Check identifies the check the plugin implements. The returned result must use
+	// its group, kind and type as group, kind and name.
+	Check CheckInfo `json:"check"`
+
+	// CurrentVersion is the version being upgraded from, or the installed version in lint mode.
+	CurrentVersion string `json:"currentVersion,omitempty"`
+
+	// TargetVersion is the version being upgraded to, or the installed version in lint mode.
+	TargetVersion string `json:"targetVersion,omitempty"`
+
+	// Kubeconfig is the path of a temporary kubeconfig holding the connection resolved from
+	// the command line, including --server, --token, --as and the other authentication
+	// flags. It is removed when the run completes.
+	Kubeconfig string `json:"kubeconfig,omitempty"`
+
+	// Context is the context of Kubeconfig to use.
+	Context string `json:"context,omitempty"`
+
+	// WorkloadScope limits workload checks to a subset of namespaces, as selected with
+	// --namespace, --namespace-selector and --exclude-namespace; nil when cluster-wide.
+	WorkloadScope *result.WorkloadScope `json:"workloadScope,omitempty"`
+}
+
+// CheckInfo describes a plugin check.
+type CheckInfo struct {
+	ID    string `json:"id"`
+	Group string `json:"group"`
+	Kind  string `json:"kind"`
+	Type  string `json:"type"`
+}
+
+// Connection selects the cluster a plugin connects to.
+type Connection struct {
+	Kubeconfig string
+	Context    string
+}
+
+// Check runs an external executable as a lint check. The executable receives a Request on
+// stdin and writes a result.DiagnosticResult as JSON to stdout. Empty output means the check
+// does not apply and is skipped. A non-zero exit, a timeout or output that is not a valid
+// result makes the check fail with ReasonCheckExecutionFailed.
+type Check struct {
+	check.BaseCheck
+
+	// Path is the path of the executable.
+	Path string
+
+	// Timeout bounds a single execution of the plugin.
+	Timeout time.Duration
+
+	connection Connection
+}
+
+// SetConnection sets the cluster the plugin connects to.
+func (c *Check) SetConnection(conn Connection) {
+	c.connection = conn
+}
+
+// CanApply returns true; plugins decide applicability themselves by returning no result.
+func (c *Check) CanApply(_ context.Context, _ check.Target) (bool, error) {
+	return true, nil
+}
+
+// Validate runs the plugin and returns the result it reports.
+func (c *Check) Validate(ctx context.Context, target check.Target) (*result.DiagnosticResult, error) {
+	req := Request{
+		Check: CheckInfo{
+			ID:    c.CheckID,
+			Group: string(c.CheckGroup),
+			Kind:  c.Kind,
+			Type:  string(c.Type),
+		},
+		Kubeconfig:    c.connection.Kubeconfig,
+		Context:       c.connection.Context,
+		WorkloadScope: target.Namespaces.Report(),
+	}
+
+	if target.CurrentVersion != nil {
+		req.CurrentVersion = target.CurrentVersion.String()
+	}
+
+	if target.TargetVersion != nil {
+		req.TargetVersion = target.TargetVersion.String()
+	}
+
+	input, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling plugin request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, c.Path) //nolint:gosec // Plugins are executables the user placed on PATH.
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("plugin %s timed out after %s", c.Path, c.Timeout)
+		}
+
+		return nil, fmt.Errorf("plugin %s failed: %w%s", c.Path, err, formatStderr(stderr.String()))
+	}
+
+	if len(bytes.TrimSpace(stdout.Bytes())) == 0 {
+		return nil, nil
+	}
+
+	var dr result.DiagnosticResult
+	if err := json.Unmarshal(stdout.Bytes(), &dr); err != nil {
+		return nil, fmt.Errorf("plugin %s returned invalid JSON: %w", c.Path, err)
+	}
+
+	if dr.Group != req.Check.Group || dr.Kind != req.Check.Kind || dr.Name != req.Check.Type {
+		return nil, fmt.Errorf("plugin %s returned result %s/%s/%s, expected %s/%s/%s", c.Path,
+			dr.Group, dr.Kind, dr.Name, req.Check.Group, req.Check.Kind, req.Check.Type)
+	}
+
+	if dr.Annotations == nil {
+		dr.Annotations = make(map[string]string)
+	}
+
+	if err := dr.Validate(); err != nil {
+		return nil, fmt.Errorf("plugin %s returned an invalid result: %w", c.Path, err)
+	}
+
+	return &dr, nil
+}
+
+// formatStderr returns the trimmed stderr as an error message suffix.
+func formatStderr(stderr string) string {
+	stderr = strings.TrimSpace(stderr)
+	if stderr == "" {
+		return ""
+	}
+
+	if len(stderr) > maxStderrLen {
+		stderr = "..." + stderr[len(stderr)-maxStderrLen:]
+	}
+
+	return ": " + stderr
+}
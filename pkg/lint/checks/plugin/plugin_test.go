@@ -0,0 +1,176 @@
+package plugin_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/plugin"
+
+	. "github.com/onsi/gomega"
+)
+
+const validResult = `{
+  "group": "workload",
+  "kind": "acme",
+  "name": "quota",
+  "spec": {"description": "Checks ACME quotas"},
+  "status": {"conditions": [{
+    "type": "Validated",
+    "status": "False",
+    "reason": "QuotaExceeded",
+    "message": "2 namespaces exceed their ACME quota",
+    "lastTransitionTime": "2026-01-01T00:00:00Z",
+    "impact": "advisory"
+  }]}
+}`
+
+// writePlugin writes an executable shell script named after the plugin check ID to dir.
+func writePlugin(t *testing.T, dir string, id string, script string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, plugin.Prefix+id)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func newPluginCheck(t *testing.T, script string) *plugin.Check {
+	t.Helper()
+
+	path := writePlugin(t, t.TempDir(), "workloads.acme.quota", script)
+
+	chk, err := plugin.NewCheck("workloads.acme.quota", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return chk
+}
+
+func newTarget(t *testing.T) check.Target {
+	t.Helper()
+
+	return testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      map[schema.GroupVersionResource]string{},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.3.0",
+	})
+}
+
+func TestCheck_Validate(t *testing.T) {
+	t.Run("should return the result written by the plugin", func(t *testing.T) {
+		g := NewWithT(t)
+
+		chk := newPluginCheck(t, "cat > /dev/null\ncat <<'JSON'\n"+validResult+"\nJSON")
+
+		dr, err := chk.Validate(t.Context(), newTarget(t))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(dr.Status.Conditions).To(HaveLen(1))
+		g.Expect(dr.Status.Conditions[0].Status).To(Equal(metav1.ConditionFalse))
+		g.Expect(dr.Status.Conditions[0].Message).To(Equal("2 namespaces exceed their ACME quota"))
+		g.Expect(dr.Annotations).ToNot(BeNil())
+	})
+
+	t.Run("should pass the request on stdin", func(t *testing.T) {
+		g := NewWithT(t)
+
+		out := filepath.Join(t.TempDir(), "request.json")
+		chk := newPluginCheck(t, "cat > "+out)
+		chk.SetConnection(plugin.Connection{Kubeconfig: "/tmp/kubeconfig", Context: "prod"})
+
+		dr, err := chk.Validate(t.Context(), newTarget(t))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(dr).To(BeNil())
+
+		data, err := os.ReadFile(out)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(data)).To(MatchJSON(`{
+			"check": {"id": "workloads.acme.quota", "group": "workload", "kind": "acme", "type": "quota"},
+			"currentVersion": "2.25.0",
+			"targetVersion": "3.3.0",
+			"kubeconfig": "/tmp/kubeconfig",
+			"context": "prod"
+		}`))
+	})
+
+	t.Run("should pass the workload scope", func(t *testing.T) {
+		g := NewWithT(t)
+
+		out := filepath.Join(t.TempDir(), "request.json")
+		chk := newPluginCheck(t, "cat > "+out)
+
+		scope, err := check.NewNamespaceScope([]string{"team-a"}, "tenant=acme", []string{"team-b"})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		target := newTarget(t)
+		target.Namespaces = scope
+
+		_, err = chk.Validate(t.Context(), target)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		data, err := os.ReadFile(out)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(data)).To(MatchJSON(`{
+			"check": {"id": "workloads.acme.quota", "group": "workload", "kind": "acme", "type": "quota"},
+			"currentVersion": "2.25.0",
+			"targetVersion": "3.3.0",
+			"workloadScope": {"namespaces": ["team-a"], "namespaceSelector": "tenant=acme", "excludedNamespaces": ["team-b"]}
+		}`))
+	})
+
+	t.Run("should fail on a non-zero exit", func(t *testing.T) {
+		g := NewWithT(t)
+
+		chk := newPluginCheck(t, "echo 'cannot reach cluster' >&2\nexit 3")
+
+		_, err := chk.Validate(t.Context(), newTarget(t))
+		g.Expect(err).To(MatchError(ContainSubstring("cannot reach cluster")))
+	})
+
+	t.Run("should fail on invalid JSON", func(t *testing.T) {
+		g := NewWithT(t)
+
+		chk := newPluginCheck(t, "echo 'all good'")
+
+		_, err := chk.Validate(t.Context(), newTarget(t))
+		g.Expect(err).To(MatchError(ContainSubstring("returned invalid JSON")))
+	})
+
+	t.Run("should fail when the result is for another check", func(t *testing.T) {
+		g := NewWithT(t)
+
+		chk := newPluginCheck(t, "cat <<'JSON'\n"+validResult+"\nJSON")
+		chk.Type = "limits"
+
+		_, err := chk.Validate(t.Context(), newTarget(t))
+		g.Expect(err).To(MatchError(ContainSubstring("expected workload/acme/limits")))
+	})
+
+	t.Run("should fail when the result has no conditions", func(t *testing.T) {
+		g := NewWithT(t)
+
+		chk := newPluginCheck(t, `echo '{"group":"workload","kind":"acme","name":"quota","status":{"conditions":[]}}'`)
+
+		_, err := chk.Validate(t.Context(), newTarget(t))
+		g.Expect(err).To(MatchError(ContainSubstring("returned an invalid result")))
+	})
+
+	t.Run("should fail when the plugin times out", func(t *testing.T) {
+		g := NewWithT(t)
+
+		chk := newPluginCheck(t, "exec sleep 5")
+		chk.Timeout = 100 * time.Millisecond
+
+		_, err := chk.Validate(t.Context(), newTarget(t))
+		g.Expect(err).To(MatchError(ContainSubstring("timed out after 100ms")))
+	})
+}
@@ -24,8 +24,14 @@ import (
 const (
 	kind                        = "datasciencepipelines"
 	checkTypeInstructLabRemoval = "instructlab-removal"
+
+	// instructLabPatchPath is the JSON pointer of the removed field in a DSPA.
+	instructLabPatchPath = "/spec/apiServer/managedPipelines/instructLab"
 )
 
+// Verify InstructLabRemovalCheck implements check.Fixer at compile time.
+var _ check.Fixer = (*InstructLabRemovalCheck)(nil)
+
 type InstructLabRemovalCheck struct {
 	check.BaseCheck
 }
@@ -40,6 +46,11 @@ func NewInstructLabRemovalCheck() *InstructLabRemovalCheck {
 			CheckName:        "Workloads :: DataSciencePipelines :: InstructLab ManagedPipelines Removal (3.x)",
 			CheckDescription: "Validates that DSPA objects do not use the removed InstructLab managedPipelines field before upgrading to RHOAI 3.x",
 			CheckRemediation: "Remove the '.spec.apiServer.managedPipelines.instructLab' field from affected DSPA objects before upgrading",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonVersionCompatible},
+				{Reason: check.ReasonResourceNotFound, Impact: result.ImpactAdvisory},
+				{Reason: check.ReasonFeatureRemoved, Impact: result.ImpactAdvisory},
+			},
 		},
 	}
 }
@@ -67,6 +78,8 @@ func (c *InstructLabRemovalCheck) Validate(ctx context.Context, target check.Tar
 				return err
 			}
 
+			dspas = check.InScope(req.Namespaces, dspas)
+
 			tv := version.MajorMinorLabel(req.TargetVersion)
 			impactedDSPAs := make([]types.NamespacedName, 0)
 
@@ -117,6 +130,34 @@ func (c *InstructLabRemovalCheck) Validate(ctx context.Context, target check.Tar
 		})
 }
 
+// Fix removes the '.spec.apiServer.managedPipelines.instructLab' field from each impacted DSPA.
+func (c *InstructLabRemovalCheck) Fix(
+	_ context.Context,
+	_ check.Target,
+	dr *result.DiagnosticResult,
+) ([]check.Patch, error) {
+	patches := make([]check.Patch, 0, len(dr.ImpactedObjects))
+
+	for _, obj := range dr.ImpactedObjects {
+		rt := resources.DataSciencePipelinesApplicationV1
+		if obj.APIVersion == resources.DataSciencePipelinesApplicationV1Alpha1.APIVersion() {
+			rt = resources.DataSciencePipelinesApplicationV1Alpha1
+		}
+
+		patch, err := check.NewJSONPatch(rt, obj.GetNamespace(), obj.GetName(),
+			[]check.JSONPatchOperation{{Op: "remove", Path: instructLabPatchPath}},
+			"Remove the deprecated managedPipelines.instructLab field",
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		patches = append(patches, patch)
+	}
+
+	return patches, nil
+}
+
 // listDSPAs attempts to list DSPAs using v1 first, falling back to v1alpha1 if v1 is not available.
 // Returns the list of DSPAs and the ResourceType that was successfully used.
 func (c *InstructLabRemovalCheck) listDSPAs(
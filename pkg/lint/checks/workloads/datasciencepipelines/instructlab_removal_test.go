@@ -211,6 +211,36 @@ func TestInstructLabRemovalCheck_MultipleDSPAsMixed(t *testing.T) {
 	g.Expect(dr.ImpactedObjects).To(HaveLen(2))
 }
 
+func TestInstructLabRemovalCheck_Fix(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	dsc := testutil.NewDSC(map[string]string{"datasciencepipelines": "Managed"})
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: instructLabListKinds,
+		Objects: []*unstructured.Unstructured{
+			dsc,
+			newDSPAv1("dspa-1", "ns1", true),
+			newDSPAv1("dspa-2", "ns2", false),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	ilCheck := datasciencepipelines.NewInstructLabRemovalCheck()
+	dr, err := ilCheck.Validate(ctx, target)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	patches, err := ilCheck.Fix(ctx, target, dr)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(patches).To(HaveLen(1))
+	g.Expect(patches[0].Resource).To(Equal(resources.DataSciencePipelinesApplicationV1))
+	g.Expect(patches[0].Namespace).To(Equal("ns1"))
+	g.Expect(patches[0].Name).To(Equal("dspa-1"))
+	g.Expect(patches[0].Type).To(Equal(check.PatchTypeJSON))
+	g.Expect(string(patches[0].Data)).To(MatchJSON(`[{"op":"remove","path":"/spec/apiServer/managedPipelines/instructLab"}]`))
+}
+
 func TestInstructLabRemovalCheck_CanApply(t *testing.T) {
 	g := NewWithT(t)
 	ctx := t.Context()
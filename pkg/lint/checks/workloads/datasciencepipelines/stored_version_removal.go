@@ -46,6 +46,12 @@ func NewStoredVersionRemovalCheck() *StoredVersionRemovalCheck {
 			CheckName:        "Workloads :: DataSciencePipelines :: v1alpha1 StoredVersion Removal (3.x)",
 			CheckDescription: "Validates that the DataSciencePipelinesApplication CRD does not have v1alpha1 in status.storedVersions before upgrading to RHOAI 3.x",
 			CheckRemediation: "Migrate all DataSciencePipelinesApplication resources from v1alpha1 to v1",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonVersionCompatible},
+				{Reason: check.ReasonResourceNotFound},
+				{Reason: check.ReasonAPIAccessDenied, Impact: result.ImpactAdvisory},
+				{Reason: check.ReasonVersionIncompatible, Impact: result.ImpactBlocking},
+			},
 		},
 	}
 }
@@ -44,6 +44,11 @@ func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
 			CheckName:        "Workloads :: Guardrails :: Impacted Workloads (3.x)",
 			CheckDescription: "Detects GuardrailsOrchestrator CRs with configuration that will be impacted in RHOAI 3.x upgrade",
 			CheckRemediation: "Review and fix GuardrailsOrchestrator configuration before upgrading to ensure correct operation in RHOAI 3.x",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonVersionCompatible},
+				{Reason: check.ReasonConfigurationValid},
+				{Reason: check.ReasonConfigurationInvalid, Impact: result.ImpactAdvisory},
+			},
 		},
 	}
 }
@@ -74,7 +79,7 @@ func (c *ImpactedWorkloadsCheck) Validate(
 		dr.Annotations[check.AnnotationCheckTargetVersion] = target.TargetVersion.String()
 	}
 
-	// List all GuardrailsOrchestrator CRs across all namespaces.
+	// List all GuardrailsOrchestrator CRs in the namespace scope.
 	orchestrators, err := client.List[*unstructured.Unstructured](
 		ctx, target.Client, resources.GuardrailsOrchestrator, nil,
 	)
@@ -82,6 +87,7 @@ func (c *ImpactedWorkloadsCheck) Validate(
 		return nil, fmt.Errorf("listing GuardrailsOrchestrators: %w", err)
 	}
 
+	orchestrators = check.InScope(target.Namespaces, orchestrators)
 	total := len(orchestrators)
 
 	var impactedCRs int
@@ -32,6 +32,10 @@ func NewOtelMigrationCheck() *OtelMigrationCheck {
 			CheckID:          "workloads.guardrails.otel-config-migration",
 			CheckName:        "Workloads :: Guardrails :: OTEL Config Migration (3.x)",
 			CheckDescription: "Detects GuardrailsOrchestrator CRs using deprecated otelExporter configuration fields that need migration",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonVersionCompatible},
+				{Reason: check.ReasonConfigurationInvalid, Impact: result.ImpactAdvisory},
+			},
 		},
 	}
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/components"
 	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
 // ConditionTypeISVCHardwareProfileCompatible indicates whether InferenceServices reference legacy hardware profiles.
@@ -36,13 +37,22 @@ func NewHardwareProfileMigrationCheck() *HardwareProfileMigrationCheck {
 			CheckName:        "Workloads :: KServe :: Legacy HardwareProfile Migration",
 			CheckDescription: "Detects InferenceService CRs carrying the legacy opendatahub.io/legacy-hardware-profile-name annotation that may need attention",
 			CheckRemediation: "Update InferenceServices to use current HardwareProfiles and remove the legacy-hardware-profile-name annotation",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonNoMigrationRequired},
+				{Reason: check.ReasonMigrationPending, Impact: result.ImpactAdvisory},
+			},
 		},
 	}
 }
 
 // CanApply returns whether this check should run for the given target.
-// Only applies when KServe is in a Managed state.
+// Only applies to upgrades, not to health checks of the installed version, when KServe
+// is in a Managed state.
 func (c *HardwareProfileMigrationCheck) CanApply(ctx context.Context, target check.Target) (bool, error) {
+	if version.SameMajorMinor(target.CurrentVersion, target.TargetVersion) {
+		return false, nil
+	}
+
 	dsc, err := client.GetDataScienceCluster(ctx, target.Client)
 	if err != nil {
 		return false, fmt.Errorf("getting DataScienceCluster: %w", err)
@@ -269,6 +269,22 @@ func TestHardwareProfileMigration_CanApply_Removed(t *testing.T) {
 	g.Expect(canApply).To(BeFalse())
 }
 
+func TestHardwareProfileMigration_CanApply_HealthCheck(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      hardwareProfileListKinds,
+		Objects:        []*unstructured.Unstructured{testutil.NewDSC(map[string]string{"kserve": "Managed"})},
+		CurrentVersion: "3.3.0",
+		TargetVersion:  "3.3.0",
+	})
+
+	chk := kserve.NewHardwareProfileMigrationCheck()
+	canApply, err := chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
+
 func TestHardwareProfileMigration_Metadata(t *testing.T) {
 	g := NewWithT(t)
 
@@ -67,6 +67,11 @@ func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
 			CheckName:        "Workloads :: KServe :: Impacted Workloads (3.x)",
 			CheckDescription: "Lists InferenceServices and ServingRuntimes using deprecated deployment modes (ModelMesh, Serverless), removed ServingRuntimes, or ServingRuntimes referencing deprecated AcceleratorProfiles that will be impacted in RHOAI 3.x",
 			CheckRemediation: "Migrate InferenceServices from Serverless/ModelMesh to RawDeployment mode, update ServingRuntimes to supported versions, and review AcceleratorProfile references before upgrading",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonVersionCompatible},
+				{Reason: check.ReasonConfigurationInvalid, Impact: result.ImpactAdvisory},
+				{Reason: check.ReasonVersionIncompatible, Impact: result.ImpactBlocking},
+			},
 		},
 		deploymentModeFilter: "all", // Default to showing all deployment modes
 	}
@@ -137,6 +142,21 @@ func (c *ImpactedWorkloadsCheck) Validate(
 		return nil, err
 	}
 
+	// Fetch InferenceServices referencing accelerator-linked ServingRuntimes
+	allISVCsFull, err := client.List[*unstructured.Unstructured](
+		ctx, target.Client, resources.InferenceService, nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Leave out workloads outside the namespace scope.
+	allISVCs = check.InScope(target.Namespaces, allISVCs)
+	impactedSRs = check.InScope(target.Namespaces, impactedSRs)
+	removedRuntimeISVCs = check.InScope(target.Namespaces, removedRuntimeISVCs)
+	acceleratorSRs = check.InScope(target.Namespaces, acceleratorSRs)
+	allISVCsFull = check.InScope(target.Namespaces, allISVCsFull)
+
 	// Split accelerator SRs into accelerator-only vs both annotations
 	var acceleratorOnlySRs, acceleratorAndHWProfileSRs []*metav1.PartialObjectMetadata
 
@@ -148,14 +168,6 @@ func (c *ImpactedWorkloadsCheck) Validate(
 		}
 	}
 
-	// Fetch InferenceServices referencing accelerator-linked ServingRuntimes
-	allISVCsFull, err := client.List[*unstructured.Unstructured](
-		ctx, target.Client, resources.InferenceService, nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-
 	tv := version.MajorMinorLabel(target.TargetVersion)
 
 	// Each function appends its condition and impacted objects to the result
@@ -36,6 +36,9 @@ const (
 	msgConfigMapReady               = "inferenceservice-config ConfigMap has %s=false and serviceAnnotationDisallowedList includes required hardware-profile annotations - ready for RHOAI %s upgrade"
 )
 
+// Verify InferenceServiceConfigCheck implements check.Fixer at compile time.
+var _ check.Fixer = (*InferenceServiceConfigCheck)(nil)
+
 // requiredDisallowedAnnotations lists annotations that must be present in the
 // inferenceService serviceAnnotationDisallowedList to prevent reconciliation
 // loops after hardware-profile migration.
@@ -68,6 +71,12 @@ func NewInferenceServiceConfigCheck() *InferenceServiceConfigCheck {
 			CheckName:        "Workloads :: KServe :: InferenceService Config Migration",
 			CheckDescription: "Validates that inferenceservice-config ConfigMap has opendatahub.io/managed=false and includes hardware-profile annotations in serviceAnnotationDisallowedList before upgrading to RHOAI 3.x",
 			CheckRemediation: "Set the annotation opendatahub.io/managed=false on the inferenceservice-config ConfigMap, and add opendatahub.io/hardware-profile-name and opendatahub.io/hardware-profile-namespace to the serviceAnnotationDisallowedList in the inferenceService data key",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonVersionCompatible},
+				{Reason: check.ReasonResourceNotFound, Impact: result.ImpactAdvisory},
+				{Reason: check.ReasonConfigurationUnmanaged, Impact: result.ImpactAdvisory},
+				{Reason: check.ReasonConfigurationInvalid, Impact: result.ImpactAdvisory},
+			},
 		},
 	}
 }
@@ -183,3 +192,108 @@ func findMissingDisallowedAnnotations(
 
 	return missing, nil
 }
+
+// Fix sets opendatahub.io/managed=false on the inferenceservice-config ConfigMap and adds
+// the missing hardware-profile annotations to serviceAnnotationDisallowedList. Other
+// fields of the inferenceService data key are preserved. When the data key is missing
+// only the annotation is set, since the full KServe configuration cannot be inferred.
+func (c *InferenceServiceConfigCheck) Fix(
+	ctx context.Context,
+	target check.Target,
+	_ *result.DiagnosticResult,
+) ([]check.Patch, error) {
+	namespace, err := client.GetApplicationsNamespace(ctx, target.Client)
+	if err != nil {
+		return nil, fmt.Errorf("getting applications namespace: %w", err)
+	}
+
+	configMap, err := target.Client.GetResource(ctx, resources.ConfigMap, inferenceServiceConfigName, client.InNamespace(namespace))
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("getting inferenceservice-config ConfigMap: %w", err)
+	case configMap == nil:
+		return nil, nil
+	}
+
+	patch := make(map[string]any)
+	var changes []string
+
+	if kube.IsManaged(configMap) {
+		patch["metadata"] = map[string]any{
+			"annotations": map[string]string{kube.AnnotationManaged: "false"},
+		}
+		changes = append(changes, "Set "+kube.AnnotationManaged+"=false")
+	}
+
+	data, added, err := addDisallowedAnnotations(configMap, requiredDisallowedAnnotations)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(added) > 0 {
+		patch["data"] = map[string]string{inferenceServiceDataKey: data}
+		changes = append(changes, "Add "+strings.Join(added, ", ")+" to serviceAnnotationDisallowedList")
+	}
+
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	p, err := check.NewMergePatch(resources.ConfigMap, namespace, inferenceServiceConfigName, patch,
+		strings.Join(changes, "; "))
+	if err != nil {
+		return nil, err
+	}
+
+	return []check.Patch{p}, nil
+}
+
+// addDisallowedAnnotations returns the inferenceService data key with the required
+// annotations appended to serviceAnnotationDisallowedList, and the annotations added.
+// Returns no additions when the data key is missing.
+func addDisallowedAnnotations(
+	configMap *unstructured.Unstructured,
+	required []string,
+) (string, []string, error) {
+	dataJSON, err := jq.Query[string](configMap, ".data."+inferenceServiceDataKey)
+	if err != nil {
+		return "", nil, nil //nolint:nilerr // Missing data key cannot be patched safely.
+	}
+
+	var cfg map[string]any
+	if err := json.Unmarshal([]byte(dataJSON), &cfg); err != nil {
+		return "", nil, fmt.Errorf("parsing %s JSON: %w", inferenceServiceDataKey, err)
+	}
+
+	var current []string
+	if list, ok := cfg["serviceAnnotationDisallowedList"].([]any); ok {
+		for _, v := range list {
+			if s, ok := v.(string); ok {
+				current = append(current, s)
+			}
+		}
+	}
+
+	var added []string
+	for _, annotation := range required {
+		if !slices.Contains(current, annotation) {
+			current = append(current, annotation)
+			added = append(added, annotation)
+		}
+	}
+
+	if len(added) == 0 {
+		return "", nil, nil
+	}
+
+	cfg["serviceAnnotationDisallowedList"] = current
+
+	updated, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return "", nil, fmt.Errorf("marshaling %s JSON: %w", inferenceServiceDataKey, err)
+	}
+
+	return string(updated), added, nil
+}
@@ -1,6 +1,7 @@
 package kserve_test
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 
@@ -347,6 +348,67 @@ func TestInferenceServiceConfigCheck_DSCINoNamespace(t *testing.T) {
 	}))
 }
 
+func TestInferenceServiceConfigCheck_Fix(t *testing.T) {
+	t.Run("sets managed=false and completes the disallowed list", func(t *testing.T) {
+		g := NewWithT(t)
+
+		configMap := newInferenceServiceConfigMap("opendatahub", nil,
+			`{"serviceAnnotationDisallowedList": ["custom"], "resource": {"cpuLimit": "1"}}`)
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds:      inferenceServiceConfigListKinds,
+			Objects:        []*unstructured.Unstructured{testutil.NewDSCI("opendatahub"), configMap},
+			CurrentVersion: "2.17.0",
+			TargetVersion:  "3.0.0",
+		})
+
+		patches, err := kserve.NewInferenceServiceConfigCheck().Fix(t.Context(), target, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(patches).To(HaveLen(1))
+		g.Expect(patches[0].Resource).To(Equal(resources.ConfigMap))
+		g.Expect(patches[0].Namespace).To(Equal("opendatahub"))
+		g.Expect(patches[0].Name).To(Equal("inferenceservice-config"))
+		g.Expect(patches[0].Type).To(Equal(check.PatchTypeMerge))
+
+		var patch struct {
+			Metadata struct {
+				Annotations map[string]string `json:"annotations"`
+			} `json:"metadata"`
+			Data map[string]string `json:"data"`
+		}
+		g.Expect(json.Unmarshal(patches[0].Data, &patch)).To(Succeed())
+		g.Expect(patch.Metadata.Annotations).To(HaveKeyWithValue("opendatahub.io/managed", "false"))
+		g.Expect(patch.Data["inferenceService"]).To(MatchJSON(`{
+			"serviceAnnotationDisallowedList": [
+				"custom",
+				"opendatahub.io/hardware-profile-name",
+				"opendatahub.io/hardware-profile-namespace"
+			],
+			"resource": {"cpuLimit": "1"}
+		}`))
+	})
+
+	t.Run("returns no patch when already configured", func(t *testing.T) {
+		g := NewWithT(t)
+
+		configMap := newInferenceServiceConfigMap("opendatahub", map[string]any{
+			"opendatahub.io/managed": "false",
+		}, inferenceServiceDataWithAnnotations(
+			"opendatahub.io/hardware-profile-name",
+			"opendatahub.io/hardware-profile-namespace",
+		))
+		target := testutil.NewTarget(t, testutil.TargetConfig{
+			ListKinds:      inferenceServiceConfigListKinds,
+			Objects:        []*unstructured.Unstructured{testutil.NewDSCI("opendatahub"), configMap},
+			CurrentVersion: "2.17.0",
+			TargetVersion:  "3.0.0",
+		})
+
+		patches, err := kserve.NewInferenceServiceConfigCheck().Fix(t.Context(), target, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(patches).To(BeEmpty())
+	})
+}
+
 func TestInferenceServiceConfigCheck_Metadata(t *testing.T) {
 	g := NewWithT(t)
 
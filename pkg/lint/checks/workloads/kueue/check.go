@@ -34,6 +34,10 @@ func NewDataIntegrityCheck() *DataIntegrityCheck {
 			CheckName:        "Workloads :: Kueue :: Data Integrity",
 			CheckDescription: "Verifies that kueue namespace labels and workload queue-name labels are consistent across the cluster",
 			CheckRemediation: remediationConsistency,
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonRequirementsMet},
+				{Reason: check.ReasonConfigurationInvalid, Impact: result.ImpactProhibited},
+			},
 		},
 	}
 }
@@ -75,6 +79,13 @@ func (c *DataIntegrityCheck) Validate(
 
 	relevantNamespaces := kueueNamespaces.Union(workloadNamespaces)
 
+	// Only namespaces in the namespace scope are checked.
+	for _, namespace := range relevantNamespaces.UnsortedList() {
+		if !target.Namespaces.Contains(namespace) {
+			relevantNamespaces.Delete(namespace)
+		}
+	}
+
 	if relevantNamespaces.Len() == 0 {
 		dr.SetCondition(check.NewCondition(
 			conditionTypeKueueConsistency,
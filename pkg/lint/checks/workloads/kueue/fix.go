@@ -0,0 +1,119 @@
+package kueue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opendatahub-io/odh-cli/pkg/constants"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+// Verify DataIntegrityCheck implements check.Fixer at compile time.
+var _ check.Fixer = (*DataIntegrityCheck)(nil)
+
+// Fix adds the missing kueue.x-k8s.io/queue-name label to impacted workloads in
+// kueue-enabled namespaces (invariant 1). The label value is the namespace's LocalQueue,
+// so workloads are only fixed when their namespace has exactly one LocalQueue.
+// Other violations require a decision about namespace labels and are not fixed.
+func (c *DataIntegrityCheck) Fix(
+	ctx context.Context,
+	target check.Target,
+	dr *result.DiagnosticResult,
+) ([]check.Patch, error) {
+	kueueNamespaces, err := kueueEnabledNamespaces(ctx, target.Client)
+	if err != nil {
+		return nil, fmt.Errorf("finding kueue-enabled namespaces: %w", err)
+	}
+
+	queues := make(map[string]string)
+
+	var patches []check.Patch
+
+	for _, obj := range dr.ImpactedObjects {
+		namespace := obj.GetNamespace()
+		if !kueueNamespaces.Has(namespace) {
+			continue
+		}
+
+		rt, ok := monitoredWorkloadType(obj.APIVersion, obj.Kind)
+		if !ok {
+			continue
+		}
+
+		current, err := target.Client.GetResourceMetadata(ctx, rt, obj.GetName(), client.InNamespace(namespace))
+		if err != nil {
+			return nil, fmt.Errorf("getting %s %s/%s: %w", rt.Kind, namespace, obj.GetName(), err)
+		}
+
+		if current == nil {
+			continue
+		}
+
+		if _, ok := current.GetLabels()[constants.LabelKueueQueueName]; ok {
+			continue
+		}
+
+		queue, ok := queues[namespace]
+		if !ok {
+			queue, err = singleLocalQueue(ctx, target.Client, namespace)
+			if err != nil {
+				return nil, err
+			}
+
+			queues[namespace] = queue
+		}
+
+		if queue == "" {
+			continue
+		}
+
+		patch, err := check.NewMergePatch(rt, namespace, obj.GetName(),
+			map[string]any{
+				"metadata": map[string]any{
+					"labels": map[string]string{constants.LabelKueueQueueName: queue},
+				},
+			},
+			fmt.Sprintf("Add %s=%s label", constants.LabelKueueQueueName, queue),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		patches = append(patches, patch)
+	}
+
+	return patches, nil
+}
+
+// monitoredWorkloadType returns the monitored workload type with the given apiVersion and kind.
+func monitoredWorkloadType(apiVersion string, kind string) (resources.ResourceType, bool) {
+	for _, rt := range monitoredWorkloadTypes {
+		if rt.APIVersion() == apiVersion && rt.Kind == kind {
+			return rt, true
+		}
+	}
+
+	return resources.ResourceType{}, false
+}
+
+// singleLocalQueue returns the name of the only LocalQueue in the namespace, or an
+// empty string when there is none or more than one.
+func singleLocalQueue(ctx context.Context, r client.Reader, namespace string) (string, error) {
+	queues, err := r.ListMetadata(ctx, resources.LocalQueue, client.WithNamespace(namespace))
+	if err != nil {
+		if client.IsResourceTypeNotFound(err) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("listing LocalQueues in namespace %s: %w", namespace, err)
+	}
+
+	if len(queues) != 1 {
+		return "", nil
+	}
+
+	return queues[0].GetName(), nil
+}
@@ -0,0 +1,71 @@
+package kueue_test
+
+import (
+	"maps"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	kueuecheck "github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/kueue"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func newLocalQueue(namespace string, name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.LocalQueue.APIVersion(),
+			"kind":       resources.LocalQueue.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": namespace,
+			},
+		},
+	}
+}
+
+func TestDataIntegrityCheck_Fix(t *testing.T) {
+	g := NewWithT(t)
+
+	fixListKinds := maps.Clone(listKinds)
+	fixListKinds[resources.LocalQueue.GVR()] = resources.LocalQueue.ListKind()
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: fixListKinds,
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSC(map[string]string{"kueue": "Unmanaged"}),
+			newNamespace("team-a", map[string]string{"kueue-managed": "true"}),
+			newNamespace("team-b", map[string]string{"kueue-managed": "true"}),
+			newNamespace("team-c", nil),
+			newLocalQueue("team-a", "default"),
+			newLocalQueue("team-b", "small"),
+			newLocalQueue("team-b", "large"),
+			// Fixable: missing label, single LocalQueue in namespace.
+			newWorkload(resources.Notebook, "team-a", "nb-a", "uid-a", nil),
+			// Not fixable: ambiguous LocalQueue.
+			newWorkload(resources.Notebook, "team-b", "nb-b", "uid-b", nil),
+			// Not fixable: namespace is not kueue-managed (invariant 2).
+			newWorkload(resources.Notebook, "team-c", "nb-c", "uid-c",
+				map[string]string{"kueue.x-k8s.io/queue-name": "default"}),
+		},
+		CurrentVersion: "2.17.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	chk := kueuecheck.NewDataIntegrityCheck()
+	dr, err := chk.Validate(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dr.ImpactedObjects).To(HaveLen(3))
+
+	patches, err := chk.Fix(t.Context(), target, dr)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(patches).To(HaveLen(1))
+	g.Expect(patches[0].Resource).To(Equal(resources.Notebook))
+	g.Expect(patches[0].Namespace).To(Equal("team-a"))
+	g.Expect(patches[0].Name).To(Equal("nb-a"))
+	g.Expect(patches[0].Type).To(Equal(check.PatchTypeMerge))
+	g.Expect(string(patches[0].Data)).To(MatchJSON(`{"metadata":{"labels":{"kueue.x-k8s.io/queue-name":"default"}}}`))
+}
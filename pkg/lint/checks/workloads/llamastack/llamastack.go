@@ -40,6 +40,10 @@ func NewConfigCheck() *ConfigCheck {
 			CheckName:        "Workloads :: LlamaStack :: Upgrade Preparation (2.x to 3.3)",
 			CheckDescription: "Identifies LlamaStackDistribution resources that require deletion and recreation for RHOAI 3.3 upgrade",
 			CheckRemediation: "Back up configurations using the backup script from rhoai-upgrade-helpers repository, coordinate with owners, then delete and recreate LlamaStackDistributions in RHOAI 3.3.0 following the migration guide",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonResourceNotFound},
+				{Reason: "ArchitecturalIncompatibility", Impact: result.ImpactBlocking},
+			},
 		},
 	}
 }
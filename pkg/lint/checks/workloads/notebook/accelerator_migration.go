@@ -31,6 +31,11 @@ func NewAcceleratorMigrationCheck() *AcceleratorMigrationCheck {
 			CheckName:        "Workloads :: Notebook :: AcceleratorProfile Migration (3.x)",
 			CheckDescription: "Detects Notebook (workbench) CRs referencing deprecated AcceleratorProfiles that will be auto-migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade",
 			CheckRemediation: "Deprecated AcceleratorProfiles will be automatically migrated to HardwareProfiles (infrastructure.opendatahub.io) during upgrade - no manual action required",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonVersionCompatible},
+				{Reason: check.ReasonResourceNotFound, Impact: result.ImpactAdvisory},
+				{Reason: check.ReasonMigrationPending, Impact: result.ImpactAdvisory},
+			},
 		},
 	}
 }
@@ -37,6 +37,10 @@ func NewConnectionIntegrityCheck() *ConnectionIntegrityCheck {
 			CheckName:        "Workloads :: Notebook :: Connection Integrity",
 			CheckDescription: "Verifies that Notebooks referencing connections have backing Secrets that exist on the cluster",
 			CheckRemediation: "Create the missing connection Secret or update the Notebook annotations to reference an existing connection",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonRequirementsMet},
+				{Reason: check.ReasonResourceNotFound, Impact: result.ImpactBlocking},
+			},
 		},
 	}
 }
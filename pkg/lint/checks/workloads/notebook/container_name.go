@@ -30,6 +30,10 @@ func NewContainerNameCheck() *ContainerNameCheck {
 			CheckName:        "Workloads :: Notebook :: Container Name Mismatch",
 			CheckDescription: "Detects Dashboard-managed Notebook (workbench) CRs where the primary container name does not match the Notebook CR name",
 			CheckRemediation: "Rename the primary container in the Notebook spec to match the Notebook CR name",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonConfigurationValid},
+				{Reason: check.ReasonConfigurationInvalid, Impact: result.ImpactAdvisory},
+			},
 		},
 	}
 }
@@ -36,6 +36,10 @@ func NewHardwareProfileIntegrityCheck() *HardwareProfileIntegrityCheck {
 			CheckName:        "Workloads :: Notebook :: HardwareProfile Integrity",
 			CheckDescription: "Verifies that Notebooks referencing infrastructure HardwareProfiles point to profiles that exist on the cluster",
 			CheckRemediation: "Create the missing HardwareProfile or update the Notebook annotations to reference an existing profile",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonRequirementsMet},
+				{Reason: check.ReasonResourceNotFound, Impact: result.ImpactBlocking},
+			},
 		},
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/validate"
 	"github.com/opendatahub-io/odh-cli/pkg/resources"
 	"github.com/opendatahub-io/odh-cli/pkg/util/kube"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
 )
 
 // HardwareProfileMigrationCheck detects Notebook CRs carrying the legacy
@@ -31,14 +32,19 @@ func NewHardwareProfileMigrationCheck() *HardwareProfileMigrationCheck {
 			CheckName:        "Workloads :: Notebook :: Legacy HardwareProfile Migration",
 			CheckDescription: "Detects Notebook CRs carrying the legacy opendatahub.io/legacy-hardware-profile-name annotation that may need attention",
 			CheckRemediation: "Update Notebooks to use current HardwareProfiles and remove the legacy-hardware-profile-name annotation",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonNoMigrationRequired},
+				{Reason: check.ReasonMigrationPending, Impact: result.ImpactAdvisory},
+			},
 		},
 	}
 }
 
 // CanApply returns whether this check should run for the given target.
-// Applies regardless of version; component state is checked via ForComponent in Validate.
-func (c *HardwareProfileMigrationCheck) CanApply(_ context.Context, _ check.Target) (bool, error) {
-	return true, nil
+// Only applies to upgrades, not to health checks of the installed version; component
+// state is checked via ForComponent in Validate.
+func (c *HardwareProfileMigrationCheck) CanApply(_ context.Context, target check.Target) (bool, error) {
+	return !version.SameMajorMinor(target.CurrentVersion, target.TargetVersion), nil
 }
 
 // Validate executes the check against the provided target.
@@ -202,6 +202,21 @@ func TestHardwareProfileMigration_CanApply(t *testing.T) {
 	g.Expect(canApply).To(BeTrue())
 }
 
+func TestHardwareProfileMigration_CanApply_HealthCheck(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds:      hardwareProfileListKinds,
+		CurrentVersion: "3.3.0",
+		TargetVersion:  "3.3.0",
+	})
+
+	chk := notebook.NewHardwareProfileMigrationCheck()
+	canApply, err := chk.CanApply(t.Context(), target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canApply).To(BeFalse())
+}
+
 func TestHardwareProfileMigration_Validate_SkipWhenDSCMissing(t *testing.T) {
 	g := NewWithT(t)
 	ctx := t.Context()
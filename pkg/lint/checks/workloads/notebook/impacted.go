@@ -117,6 +117,10 @@ func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
 			CheckName:        "Workloads :: Notebook :: Impacted Workloads (3.x)",
 			CheckDescription: "Identifies Notebook (workbench) instances with images that will not work in RHOAI 3.x",
 			CheckRemediation: "Update workbenches with incompatible images to use 2025.2+ versions before upgrading",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonVersionCompatible},
+				{Reason: check.ReasonWorkloadsImpacted, Impact: result.ImpactAdvisory},
+			},
 		},
 	}
 }
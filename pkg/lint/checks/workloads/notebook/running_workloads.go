@@ -30,6 +30,10 @@ func NewRunningWorkloadsCheck() *RunningWorkloadsCheck {
 			CheckName:        "Workloads :: Notebook :: Running Workloads",
 			CheckDescription: "Detects Notebook CRs that are currently running (not stopped) on the cluster",
 			CheckRemediation: "Save all pending work in running Notebooks, then stop them before upgrading",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonRequirementsMet},
+				{Reason: check.ReasonWorkloadsImpacted, Impact: result.ImpactAdvisory},
+			},
 		},
 	}
 }
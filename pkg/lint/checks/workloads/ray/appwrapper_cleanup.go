@@ -37,6 +37,10 @@ func NewAppWrapperCleanupCheck() *AppWrapperCleanupCheck {
 			CheckName:        "Workloads :: Ray :: AppWrapper Cleanup (3.x)",
 			CheckDescription: "Lists AppWrappers managed by CodeFlare that will be impacted in RHOAI 3.x",
 			CheckRemediation: "Remove redundant AppWrapper CRs or install the AppWrapper controller separately before upgrading",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonVersionCompatible},
+				{Reason: check.ReasonVersionIncompatible, Impact: result.ImpactAdvisory},
+			},
 		},
 	}
 }
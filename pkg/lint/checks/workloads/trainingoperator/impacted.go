@@ -35,6 +35,10 @@ func NewImpactedWorkloadsCheck() *ImpactedWorkloadsCheck {
 			CheckName:        "Workloads :: TrainingOperator :: Impacted Workloads (3.3+)",
 			CheckDescription: "Lists PyTorchJobs using deprecated TrainingOperator (Kubeflow v1) that will be impacted by transition to Trainer v2",
 			CheckRemediation: "Complete or delete active PyTorchJobs before upgrading; plan migration to Trainer v2 API",
+			CheckConditions: []check.ConditionSpec{
+				{Reason: check.ReasonVersionCompatible},
+				{Reason: check.ReasonWorkloadsImpacted, Impact: result.ImpactAdvisory},
+			},
 		},
 	}
 }
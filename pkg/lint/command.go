@@ -4,17 +4,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"slices"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/spf13/pflag"
 
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/opendatahub-io/odh-cli/pkg/cmd"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
-	resultpkg "github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/dashboard"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/datasciencepipelines"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/kserve"
@@ -22,10 +26,12 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/modelmesh"
 	raycomponent "github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/ray"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/components/trainingoperator"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/declarative"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/certmanager"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/dependencies/openshift"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/platform/datasciencecluster"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/platform/dscinitialization"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/plugin"
 	datasciencepipelinesworkloads "github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/datasciencepipelines"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/guardrails"
 	kserveworkloads "github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/kserve"
@@ -52,10 +58,38 @@ type Command struct {
 	// If set, runs in upgrade mode (assesses upgrade readiness to target version).
 	TargetVersion string
 
+	// ReportFile is an optional path where a self-contained HTML report is written,
+	// in addition to the output selected with --output.
+	ReportFile string
+
+	// FailOn selects the findings that make the command exit with a non-zero code.
+	FailOn FailOn
+
+	// WaiversFile is an optional path to a YAML file of waivers accepting known findings.
+	WaiversFile string
+
+	// Contexts lists the kubeconfig contexts to lint as a fleet. Each cluster is assessed
+	// with its own detected version and the results are combined into one report.
+	Contexts []string
+
+	// AllContexts lints every context in the kubeconfig as a fleet.
+	AllContexts bool
+
+	// Publish writes the results to the report ConfigMap in the applications namespace
+	// of the cluster, so that they can be viewed in the OpenShift console.
+	Publish bool
+
 	// ISVCDeploymentMode filters InferenceService display by deployment mode.
 	// Valid values: "all" (default), "serverless", "modelmesh".
 	ISVCDeploymentMode string
 
+	// fleetContexts are the kubeconfig contexts resolved from Contexts or AllContexts
+	// during Complete
+	fleetContexts []string
+
+	// waivers are loaded from WaiversFile during Complete
+	waivers []Waiver
+
 	// parsedTargetVersion is the parsed semver version (upgrade mode only)
 	parsedTargetVersion *semver.Version
 
@@ -65,6 +99,14 @@ type Command struct {
 	// currentOpenShiftVersion stores the detected OpenShift platform version (populated during Run)
 	currentOpenShiftVersion string
 
+	// namespaces is the resolved namespace scope of workload checks (populated during Run),
+	// nil when they run cluster-wide
+	namespaces *check.NamespaceScope
+
+	// healthCheck is set when Run validates the current installation (lint mode), in which
+	// case reports carry no target version
+	healthCheck bool
+
 	// registry is the check registry for this command instance.
 	// Explicitly populated to avoid global state and enable test isolation.
 	registry *check.CheckRegistry
@@ -79,7 +121,23 @@ func NewCommand(
 	configFlags *genericclioptions.ConfigFlags,
 	options ...CommandOption,
 ) *Command {
-	shared := NewSharedOptions(streams, configFlags)
+	c := &Command{
+		SharedOptions:      NewSharedOptions(streams, configFlags),
+		registry:           newCheckRegistry(),
+		ISVCDeploymentMode: "all",
+		FailOn:             FailOnProhibited,
+	}
+
+	// Apply functional options
+	for _, opt := range options {
+		opt(c)
+	}
+
+	return c
+}
+
+// newCheckRegistry creates a registry populated with every lint check.
+func newCheckRegistry() *check.CheckRegistry {
 	registry := check.NewRegistry()
 
 	// Explicitly register all checks (no global state, full test isolation)
@@ -87,7 +145,7 @@ func NewCommand(
 	registry.MustRegister(dscinitialization.NewDSCInitializationReadinessCheck())
 	registry.MustRegister(datasciencecluster.NewDataScienceClusterReadinessCheck())
 
-	// Components (12)
+	// Components (14)
 	registry.MustRegister(raycomponent.NewCodeFlareRemovalCheck())
 	registry.MustRegister(dashboard.NewAcceleratorProfileMigrationCheck())
 	registry.MustRegister(dashboard.NewHardwareProfileMigrationCheck())
@@ -95,6 +153,8 @@ func NewCommand(
 	registry.MustRegister(kserve.NewServerlessRemovalCheck())
 	registry.MustRegister(kserve.NewKuadrantReadinessCheck())
 	registry.MustRegister(kserve.NewAuthorinoTLSReadinessCheck())
+	registry.MustRegister(kserve.NewKuadrantHealthCheck())
+	registry.MustRegister(kserve.NewAuthorinoTLSHealthCheck())
 	registry.MustRegister(kserve.NewServiceMeshOperatorCheck())
 	registry.MustRegister(kserve.NewServiceMeshRemovalCheck())
 	registry.MustRegister(kueue.NewManagementStateCheck())
@@ -129,18 +189,32 @@ func NewCommand(
 	registry.MustRegister(ray.NewImpactedWorkloadsCheck())
 	registry.MustRegister(trainingoperatorworkloads.NewImpactedWorkloadsCheck())
 
-	c := &Command{
-		SharedOptions:      shared,
-		registry:           registry,
-		ISVCDeploymentMode: "all",
+	// Declarative checks shipped with the CLI
+	for _, chk := range declarative.MustLoadEmbedded() {
+		registry.MustRegister(chk)
 	}
 
-	// Apply functional options
-	for _, opt := range options {
-		opt(c)
+	return registry
+}
+
+// registerCheckDir registers the declarative checks defined in dir, if set.
+func registerCheckDir(registry *check.CheckRegistry, dir string) error {
+	if dir == "" {
+		return nil
 	}
 
-	return c
+	checks, err := declarative.LoadDir(dir)
+	if err != nil {
+		return fmt.Errorf("loading checks: %w", err)
+	}
+
+	for _, chk := range checks {
+		if err := registry.Register(chk); err != nil {
+			return fmt.Errorf("registering checks from %s: %w", dir, err)
+		}
+	}
+
+	return nil
 }
 
 // AddFlags registers command-specific flags with the provided FlagSet.
@@ -152,7 +226,19 @@ func (c *Command) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVarP(&c.Verbose, "verbose", "v", false, flagDescVerbose)
 	fs.BoolVar(&c.Debug, "debug", false, flagDescDebug)
 	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescTimeout)
+	fs.IntVar(&c.Parallelism, "parallelism", c.Parallelism, flagDescParallelism)
+	fs.StringArrayVar(&c.CheckTimeouts, "check-timeout", nil, flagDescCheckTimeout)
 	fs.StringVar(&c.ISVCDeploymentMode, "isvc-deployment-mode", "all", flagDescISVCDeploymentMode)
+	fs.StringVar(&c.FromSnapshot, "from-snapshot", "", flagDescFromSnapshot)
+	fs.StringVar(&c.CheckDir, "check-dir", "", flagDescCheckDir)
+	fs.StringVar(&c.ReportFile, "report-file", "", flagDescReportFile)
+	fs.StringVar(&c.WaiversFile, "waivers", "", flagDescWaivers)
+	fs.BoolVar(&c.Publish, "publish", false, flagDescPublish)
+	fs.StringVar((*string)(&c.FailOn), "fail-on", string(FailOnProhibited), flagDescFailOn)
+	fs.StringSliceVar(&c.Contexts, "contexts", nil, flagDescContexts)
+	fs.BoolVar(&c.AllContexts, "all-contexts", false, flagDescAllContexts)
+	fs.StringVar(&c.NamespaceSelector, "namespace-selector", "", flagDescNamespaceSelector)
+	fs.StringArrayVar(&c.ExcludeNamespaces, "exclude-namespace", nil, flagDescExcludeNamespace)
 
 	// Throttling settings
 	fs.Float32Var(&c.QPS, "qps", c.QPS, flagDescQPS)
@@ -161,9 +247,18 @@ func (c *Command) AddFlags(fs *pflag.FlagSet) {
 
 // Complete populates Options and performs pre-validation setup.
 func (c *Command) Complete() error {
-	// Complete shared options (creates client)
-	if err := c.SharedOptions.Complete(); err != nil {
-		return fmt.Errorf("completing shared options: %w", err)
+	if c.fleetMode() {
+		// One client per context is created when the fleet is linted.
+		contexts, err := ResolveContexts(c.ConfigFlags, c.Contexts, c.AllContexts)
+		if err != nil {
+			return fmt.Errorf("resolving kubeconfig contexts: %w", err)
+		}
+		c.fleetContexts = contexts
+	} else {
+		// Complete shared options (creates client)
+		if err := c.SharedOptions.Complete(); err != nil {
+			return fmt.Errorf("completing shared options: %w", err)
+		}
 	}
 
 	// Wrap IO with QuietWrapper if NOT in verbose or debug mode (default is quiet)
@@ -182,6 +277,42 @@ func (c *Command) Complete() error {
 	}
 	// If no target version provided, we're in lint mode (will use current version)
 
+	if err := registerCheckDir(c.registry, c.CheckDir); err != nil {
+		return err
+	}
+
+	// Plugins connect to the cluster themselves, so they cannot lint a snapshot.
+	if c.FromSnapshot == "" {
+		if err := registerPlugins(c.registry, c.IO); err != nil {
+			return err
+		}
+	}
+
+	if c.WaiversFile != "" {
+		waivers, err := LoadWaivers(c.WaiversFile)
+		if err != nil {
+			return fmt.Errorf("loading waivers: %w", err)
+		}
+		c.waivers = waivers
+	}
+
+	return nil
+}
+
+// registerPlugins registers the check plugins found on PATH.
+func registerPlugins(registry *check.CheckRegistry, streams iostreams.Interface) error {
+	plugins, warnings := plugin.Discover(os.Getenv("PATH"))
+
+	for _, w := range warnings {
+		streams.Errorf("Warning: %s", w)
+	}
+
+	for _, p := range plugins {
+		if err := registry.Register(p); err != nil {
+			return fmt.Errorf("registering check plugin %s: %w", p.Path, err)
+		}
+	}
+
 	return nil
 }
 
@@ -198,161 +329,359 @@ func (c *Command) Validate() error {
 		return fmt.Errorf("invalid isvc-deployment-mode: %s (must be one of: all, serverless, modelmesh)", c.ISVCDeploymentMode)
 	}
 
-	return nil
+	if err := c.FailOn.Validate(); err != nil {
+		return err
+	}
+
+	if c.Publish && c.FromSnapshot != "" {
+		return errors.New("--publish cannot be used with --from-snapshot")
+	}
+
+	return c.validateFleet()
 }
 
 // Run executes the lint command in either lint or upgrade mode.
 func (c *Command) Run(ctx context.Context) error {
+	if c.fleetMode() {
+		return c.runFleet(ctx)
+	}
+
 	// Create context with timeout to prevent hanging on slow clusters
 	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
 	defer cancel()
 
+	if c.FromSnapshot != "" {
+		c.IO.Errorf("Linting cluster snapshot from %s", c.FromSnapshot)
+	}
+
+	// Checks read many of the same resources; share one cache across this run.
+	cache := client.NewCachedReader(c.Reader)
+	c.Reader = cache
+
+	defer c.printCacheStats(cache)
+
+	results, err := c.assess(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Format and output results
+	if err := c.formatAndOutputUpgradeResults(ctx, c.currentClusterVersion, results); err != nil {
+		return err
+	}
+
+	if c.ReportFile != "" {
+		if err := c.writeReportFile(ctx, results); err != nil {
+			return err
+		}
+	}
+
+	if c.Publish {
+		if err := c.publishReport(ctx, results); err != nil {
+			return err
+		}
+	}
+
+	// Print verdict and determine exit code
+	return c.printVerdictAndExit(results)
+}
+
+// assess detects the cluster version, selects lint or upgrade mode and returns the
+// results of the executed checks, with waivers and the severity filter applied.
+func (c *Command) assess(ctx context.Context) ([]check.CheckExecution, error) {
 	// Detect current cluster version (needed for both modes)
-	currentVersion, err := version.Detect(ctx, c.Client)
+	currentVersion, err := version.Detect(ctx, c.Reader)
 	if err != nil {
-		return fmt.Errorf("detecting cluster version: %w", err)
+		return nil, fmt.Errorf("detecting cluster version: %w", err)
 	}
 
 	// Store current version for output formatting
 	c.currentClusterVersion = currentVersion.String()
 
 	// Detect OpenShift platform version (informational, non-fatal)
-	ocpVersion, err := version.DetectOpenShiftVersion(ctx, c.Client)
+	ocpVersion, err := version.DetectOpenShiftVersion(ctx, c.Reader)
 	if err != nil {
 		c.IO.Errorf("Warning: Failed to detect OpenShift version: %v", err)
 	} else {
 		c.currentOpenShiftVersion = ocpVersion.String()
 	}
 
+	scope, err := c.NamespaceScope()
+	if err != nil {
+		return nil, err
+	}
+
+	c.namespaces, err = scope.Resolve(ctx, c.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("resolving namespace scope: %w", err)
+	}
+
 	// Determine effective target version (defaults to current for lint mode)
 	targetVersion := currentVersion
 	if c.parsedTargetVersion != nil {
 		targetVersion = c.parsedTargetVersion
 	}
 
-	// Same major.minor means no upgrade checks are needed (checked before
-	// the downgrade guard so that e.g. --target-version 2.25 with current
-	// 2.25.2 is treated as "same version", not as a downgrade).
+	// Same major.minor means there is no upgrade to assess, so the current
+	// installation is validated instead (checked before the downgrade guard
+	// so that e.g. --target-version 2.25 with current 2.25.2 is treated as
+	// "same version", not as a downgrade).
 	if version.SameMajorMinor(currentVersion, targetVersion) {
 		return c.runLintMode(ctx, currentVersion)
 	}
 
 	// Reject downgrades when explicit --target-version is provided
 	if targetVersion.LT(*currentVersion) {
-		return fmt.Errorf("target version %s is older than current version %s (downgrades not supported)",
+		return nil, fmt.Errorf("target version %s is older than current version %s (downgrades not supported)",
 			c.TargetVersion, currentVersion.String())
 	}
 
 	return c.runUpgradeMode(ctx, currentVersion)
 }
 
-// configureCheckSettings applies command-level settings to specific checks.
-func (c *Command) configureCheckSettings() {
-	// Apply ISVC deployment mode filter to the KServe impacted workloads check
+// printCacheStats reports how many reads were served from the run's cache when --debug is set.
+func (c *Command) printCacheStats(cache *client.CachedReader) {
+	if !c.Debug {
+		return
+	}
+
+	stats := cache.Stats()
+	c.IO.Errorf("Read cache: %d hits, %d misses", stats.Hits, stats.Misses)
+}
+
+// configureCheckSettings applies command-level settings to specific checks. The returned
+// function releases resources created for the run.
+func (c *Command) configureCheckSettings() (func(), error) {
 	for _, chk := range c.registry.ListAll() {
-		if isvcCheck, ok := chk.(*kserveworkloads.ImpactedWorkloadsCheck); ok {
-			isvcCheck.SetDeploymentModeFilter(c.ISVCDeploymentMode)
+		if typed, ok := chk.(*kserveworkloads.ImpactedWorkloadsCheck); ok {
+			// Apply ISVC deployment mode filter to the KServe impacted workloads check
+			typed.SetDeploymentModeFilter(c.ISVCDeploymentMode)
 		}
 	}
+
+	return connectPlugins(c.registry, c.ConfigFlags)
 }
 
-// runLintMode validates current cluster state.
-//
-//nolint:unparam // keep explicit error return value
-func (c *Command) runLintMode(_ context.Context, currentVersion *semver.Version) error {
-	c.IO.Fprintln()
-	outputVersionInfo(c.IO.Out(), &VersionInfo{
-		RHOAICurrentVersion: currentVersion.String(),
-		OpenShiftVersion:    c.currentOpenShiftVersion,
-	})
+// connectPlugins sets the connection of the check plugins of registry to the cluster
+// selected on the command line. The connection is written once and shared by every
+// plugin of the run; the returned function removes it.
+func connectPlugins(registry *check.CheckRegistry, configFlags *genericclioptions.ConfigFlags) (func(), error) {
+	cleanup := func() {}
 
-	c.IO.Fprintln()
-	c.IO.Fprintf("Current and target versions are the same (%s), no checks will be executed.",
-		version.MajorMinorLabel(currentVersion))
+	var conn *plugin.Connection
 
-	return nil
+	for _, chk := range registry.ListAll() {
+		typed, ok := chk.(*plugin.Check)
+		if !ok {
+			continue
+		}
+
+		if conn == nil {
+			written, remove, err := writePluginConnection(configFlags)
+			if err != nil {
+				return cleanup, err
+			}
+
+			conn, cleanup = &written, remove
+		}
+
+		typed.SetConnection(*conn)
+	}
+
+	return cleanup, nil
+}
+
+// writePluginConnection writes the connection resolved from the command line flags,
+// including --server, --token, --as and the other authentication flags, to a temporary
+// kubeconfig for plugins. The returned function removes it. The context has no
+// namespace: -n selects the workload scope, which plugins receive as WorkloadScope.
+func writePluginConnection(configFlags *genericclioptions.ConfigFlags) (plugin.Connection, func(), error) {
+	restConfig, err := configFlags.ToRESTConfig()
+	if err != nil {
+		return plugin.Connection{}, nil, fmt.Errorf("resolving plugin connection: %w", err)
+	}
+
+	dir, err := os.MkdirTemp("", "odh-lint-plugin-")
+	if err != nil {
+		return plugin.Connection{}, nil, fmt.Errorf("creating plugin kubeconfig: %w", err)
+	}
+
+	remove := func() { _ = os.RemoveAll(dir) }
+
+	kubeconfig := client.NewKubeconfig(restConfig, "")
+	path := filepath.Join(dir, "kubeconfig")
+
+	if err := clientcmd.WriteToFile(*kubeconfig, path); err != nil {
+		remove()
+
+		return plugin.Connection{}, nil, fmt.Errorf("writing plugin kubeconfig: %w", err)
+	}
+
+	return plugin.Connection{Kubeconfig: path, Context: kubeconfig.CurrentContext}, remove, nil
+}
+
+// stringValue returns the value of s, or an empty string if s is nil.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+// runLintMode validates the current installation. Every check whose CanApply accepts
+// CurrentVersion == TargetVersion is executed, producing a post-install health report.
+func (c *Command) runLintMode(ctx context.Context, currentVersion *semver.Version) ([]check.CheckExecution, error) {
+	c.IO.Errorf("Validating current installation: %s\n", currentVersion.String())
+
+	// Health checks report against the installed version only.
+	c.healthCheck = true
+
+	c.IO.Errorf("Running health checks...")
+
+	return c.runChecks(ctx, check.Target{
+		Client:         c.Reader,
+		CurrentVersion: currentVersion,
+		TargetVersion:  currentVersion,
+		Resource:       nil,
+		Namespaces:     c.namespaces,
+		IO:             c.IO,
+		Debug:          c.Debug,
+	})
 }
 
 // runUpgradeMode assesses upgrade readiness for a target version.
-func (c *Command) runUpgradeMode(ctx context.Context, currentVersion *semver.Version) error {
+func (c *Command) runUpgradeMode(ctx context.Context, currentVersion *semver.Version) ([]check.CheckExecution, error) {
 	c.IO.Errorf("Assessing upgrade readiness: %s → %s\n", currentVersion.String(), c.TargetVersion)
 
-	// Configure check-specific settings
-	c.configureCheckSettings()
-
-	// Execute checks using target version for applicability filtering
 	c.IO.Errorf("Running upgrade compatibility checks...")
-	executor := check.NewExecutor(c.registry, c.IO)
 
 	// Create check target with BOTH current and target versions for upgrade checks
-	checkTarget := check.Target{
-		Client:         c.Client,
+	return c.runChecks(ctx, check.Target{
+		Client:         c.Reader,
 		CurrentVersion: currentVersion,        // The version we're upgrading FROM
 		TargetVersion:  c.parsedTargetVersion, // The version we're upgrading TO
 		Resource:       nil,
+		Namespaces:     c.namespaces,
 		IO:             c.IO,
 		Debug:          c.Debug,
+	})
+}
+
+// runChecks executes the selected checks against checkTarget and returns the results
+// with waivers and the severity filter applied. It is shared by lint and upgrade modes.
+func (c *Command) runChecks(ctx context.Context, checkTarget check.Target) ([]check.CheckExecution, error) {
+	// Configure check-specific settings
+	cleanup, err := c.configureCheckSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	defer cleanup()
+
+	// Execute checks using target version for applicability filtering
+	opts, err := c.executorOptions(c.registry)
+	if err != nil {
+		return nil, err
+	}
+
+	executor := check.NewExecutor(c.registry, c.IO, opts...)
+
+	flatResults, err := executeChecks(ctx, executor, checkTarget, c.CheckSelectors)
+	if err != nil {
+		return nil, err
 	}
 
+	if err := c.applyWaivers(flatResults); err != nil {
+		return nil, err
+	}
+
+	return FilterBySeverity(flatResults, c.SeverityLevel), nil
+}
+
+// executeChecks runs the selected checks against checkTarget and returns the results in
+// output order, without the checks that reported no result.
+func executeChecks(
+	ctx context.Context,
+	executor *check.Executor,
+	checkTarget check.Target,
+	selectors []string,
+) ([]check.CheckExecution, error) {
 	// Execute checks in canonical order: dependencies → services → platform → components → workloads
 	resultsByGroup := make(map[check.CheckGroup][]check.CheckExecution)
 
 	for _, group := range check.CanonicalGroupOrder {
-		results, err := executor.ExecuteSelective(ctx, checkTarget, c.CheckSelectors, group)
+		results, err := executor.ExecuteSelective(ctx, checkTarget, selectors, group)
 		if err != nil {
-			return fmt.Errorf("executing %s checks: %w", group, err)
+			return nil, fmt.Errorf("executing %s checks: %w", group, err)
 		}
 
 		resultsByGroup[group] = results
 	}
 
-	// Flatten, strip nil results, and apply severity filter
+	// Flatten and strip nil results
 	flatResults := FlattenResults(resultsByGroup)
-	flatResults = slices.DeleteFunc(flatResults, func(exec check.CheckExecution) bool {
+
+	return slices.DeleteFunc(flatResults, func(exec check.CheckExecution) bool {
 		return exec.Result == nil
-	})
-	flatResults = FilterBySeverity(flatResults, c.SeverityLevel)
+	}), nil
+}
 
-	// Format and output results
-	if err := c.formatAndOutputUpgradeResults(ctx, currentVersion.String(), flatResults); err != nil {
-		return err
+// applyWaivers marks waived findings and warns about expired waivers.
+func (c *Command) applyWaivers(results []check.CheckExecution) error {
+	if len(c.waivers) == 0 {
+		return nil
 	}
 
-	// Print verdict and determine exit code
-	return c.printVerdictAndExit(flatResults)
+	expired, err := ApplyWaivers(results, c.waivers, time.Now())
+	if err != nil {
+		return fmt.Errorf("applying waivers: %w", err)
+	}
+
+	warnExpiredWaivers(c.IO, expired)
+
+	return nil
+}
+
+// warnExpiredWaivers warns about waivers that were not applied because they expired. The
+// warning is written to stderr directly so that it is shown in quiet mode too.
+func warnExpiredWaivers(streams iostreams.Interface, expired []Waiver) {
+	for _, w := range expired {
+		_, _ = fmt.Fprintf(streams.ErrOut(), "Warning: waiver for %q expired on %s and was not applied (%s)\n",
+			w.Check, w.Expires, w.Reason)
+	}
 }
 
 // printVerdictAndExit prints a prominent result verdict for table output and returns
-// an error if fail-on conditions are met (to control exit code).
+// a *VerdictError if findings meet the --fail-on policy (to control exit code).
+// Waived findings are ignored.
 func (c *Command) printVerdictAndExit(results []check.CheckExecution) error {
-	var hasProhibited, hasBlocking, hasAdvisory bool
+	findings := SummarizeFindings(results)
 
-	for _, exec := range results {
-		if exec.Result == nil {
-			continue
-		}
-
-		switch exec.Result.GetImpact() {
-		case resultpkg.ImpactProhibited:
-			hasProhibited = true
-		case resultpkg.ImpactBlocking:
-			hasBlocking = true
-		case resultpkg.ImpactAdvisory:
-			hasAdvisory = true
-		case resultpkg.ImpactNone:
-			// No impact on exit code
-		}
+	if c.OutputFormat == OutputFormatTable {
+		printVerdict(c.IO.Out(), findings)
 	}
 
-	if c.OutputFormat == OutputFormatTable {
-		printVerdict(c.IO.Out(), hasProhibited, hasBlocking, hasAdvisory)
+	return findings.Evaluate(c.FailOn)
+}
+
+// reportTargetVersion returns the target version shown in reports, or an empty string
+// when validating the current installation.
+func (c *Command) reportTargetVersion() string {
+	if c.healthCheck {
+		return ""
 	}
 
-	if hasProhibited {
-		return errors.New("prohibited findings detected: upgrade is not possible")
+	return c.TargetVersion
+}
+
+// targetVersionPtr returns the report target version as *string, or nil if empty.
+func (c *Command) targetVersionPtr() *string {
+	if c.reportTargetVersion() == "" {
+		return nil
 	}
 
-	return nil
+	return &c.TargetVersion
 }
 
 // openShiftVersionPtr returns the OpenShift version as *string, or nil if empty.
@@ -364,6 +693,16 @@ func (c *Command) openShiftVersionPtr() *string {
 	return &c.currentOpenShiftVersion
 }
 
+// workloadScope describes the namespace scope of workload checks for report headers,
+// or returns an empty string when they ran cluster-wide.
+func (c *Command) workloadScope() string {
+	if c.namespaces == nil {
+		return ""
+	}
+
+	return c.namespaces.String()
+}
+
 // formatAndOutputUpgradeResults formats upgrade assessment results.
 func (c *Command) formatAndOutputUpgradeResults(
 	ctx context.Context,
@@ -371,29 +710,113 @@ func (c *Command) formatAndOutputUpgradeResults(
 	results []check.CheckExecution,
 ) error {
 	clusterVer := &c.currentClusterVersion
-	targetVer := &c.TargetVersion
+	targetVer := c.targetVersionPtr()
 	ocpVer := c.openShiftVersionPtr()
 
 	switch c.OutputFormat {
 	case OutputFormatTable:
 		return c.outputUpgradeTable(ctx, currentVer, results)
 	case OutputFormatJSON:
-		if err := OutputJSON(c.IO.Out(), results, clusterVer, targetVer, ocpVer); err != nil {
+		list := newDiagnosticResultList(results, clusterVer, targetVer, ocpVer)
+		list.WorkloadScope = c.namespaces.Report()
+
+		if err := renderJSONList(c.IO.Out(), list); err != nil {
 			return fmt.Errorf("outputting JSON: %w", err)
 		}
 
 		return nil
 	case OutputFormatYAML:
-		if err := OutputYAML(c.IO.Out(), results, clusterVer, targetVer, ocpVer); err != nil {
+		list := newDiagnosticResultList(results, clusterVer, targetVer, ocpVer)
+		list.WorkloadScope = c.namespaces.Report()
+
+		if err := renderYAMLList(c.IO.Out(), list); err != nil {
 			return fmt.Errorf("outputting YAML: %w", err)
 		}
 
+		return nil
+	case OutputFormatHTML:
+		return c.outputHTML(ctx, c.IO.Out(), results)
+	case OutputFormatJUnit:
+		if err := OutputJUnit(c.IO.Out(), results, clusterVer, targetVer, ocpVer); err != nil {
+			return fmt.Errorf("outputting JUnit: %w", err)
+		}
+
+		return nil
+	case OutputFormatSARIF:
+		if err := OutputSARIF(c.IO.Out(), results, clusterVer, targetVer, ocpVer); err != nil {
+			return fmt.Errorf("outputting SARIF: %w", err)
+		}
+
 		return nil
 	default:
 		return fmt.Errorf("unsupported output format: %s", c.OutputFormat)
 	}
 }
 
+// outputHTML renders the HTML report, including namespace requesters for impacted objects.
+func (c *Command) outputHTML(ctx context.Context, out io.Writer, results []check.CheckExecution) error {
+	opts := HTMLOutputOptions{
+		VersionInfo: &VersionInfo{
+			RHOAICurrentVersion: c.currentClusterVersion,
+			RHOAITargetVersion:  c.reportTargetVersion(),
+			OpenShiftVersion:    c.currentOpenShiftVersion,
+			WorkloadScope:       c.workloadScope(),
+		},
+		NamespaceRequesters: collectNamespaceRequesters(ctx, c.Reader, results),
+	}
+
+	if err := OutputHTML(out, results, opts); err != nil {
+		return fmt.Errorf("outputting HTML: %w", err)
+	}
+
+	return nil
+}
+
+// writeReportFile writes the HTML report to ReportFile.
+func (c *Command) writeReportFile(ctx context.Context, results []check.CheckExecution) error {
+	f, err := os.Create(c.ReportFile)
+	if err != nil {
+		return fmt.Errorf("creating report file: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	if err := c.outputHTML(ctx, f, results); err != nil {
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing report file: %w", err)
+	}
+
+	c.IO.Errorf("HTML report written to %s", c.ReportFile)
+
+	return nil
+}
+
+// publishReport writes the results to the report ConfigMap in the applications namespace.
+// It runs even when the run timed out, so that an incomplete report is published too.
+func (c *Command) publishReport(ctx context.Context, results []check.CheckExecution) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), publishTimeout)
+	defer cancel()
+
+	namespace, err := client.GetApplicationsNamespace(ctx, c.Reader)
+	if err != nil {
+		return fmt.Errorf("publishing report: detecting applications namespace: %w", err)
+	}
+
+	list := newDiagnosticResultList(results, &c.currentClusterVersion, c.targetVersionPtr(), c.openShiftVersionPtr())
+	list.WorkloadScope = c.namespaces.Report()
+
+	if err := PublishReport(ctx, c.Client, namespace, list); err != nil {
+		return fmt.Errorf("publishing report: %w", err)
+	}
+
+	c.IO.Errorf("Report published to ConfigMap %s/%s", namespace, ReportConfigMapName)
+
+	return nil
+}
+
 // outputUpgradeTable outputs upgrade results in table format with header.
 func (c *Command) outputUpgradeTable(ctx context.Context, _ string, results []check.CheckExecution) error {
 	c.IO.Fprintln()
@@ -402,13 +825,14 @@ func (c *Command) outputUpgradeTable(ctx context.Context, _ string, results []ch
 		ShowImpactedObjects: c.Verbose,
 		VersionInfo: &VersionInfo{
 			RHOAICurrentVersion: c.currentClusterVersion,
-			RHOAITargetVersion:  c.TargetVersion,
+			RHOAITargetVersion:  c.reportTargetVersion(),
 			OpenShiftVersion:    c.currentOpenShiftVersion,
+			WorkloadScope:       c.workloadScope(),
 		},
 	}
 
 	if c.Verbose {
-		opts.NamespaceRequesters = collectNamespaceRequesters(ctx, c.Client, results)
+		opts.NamespaceRequesters = collectNamespaceRequesters(ctx, c.Reader, results)
 	}
 
 	// Reuse the lint table output logic
@@ -0,0 +1,216 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+)
+
+// Verify DiffCommand implements cmd.Command interface at compile time.
+var _ cmd.Command = (*DiffCommand)(nil)
+
+//nolint:gochecknoglobals
+var diffTableHeaders = []string{"CHANGE", "GROUP", "KIND", "CHECK", "IMPACT", "OBJECTS"}
+
+// DiffCommand compares two reports written by `lint -o json` (or yaml). It needs no
+// cluster access.
+type DiffCommand struct {
+	// IO provides structured access to stdin, stdout, stderr with convenience methods
+	IO iostreams.Interface
+
+	// OutputFormat specifies the output format (table, json)
+	OutputFormat OutputFormat
+
+	// OldReport and NewReport are the paths of the reports to compare.
+	OldReport string
+	NewReport string
+}
+
+// NewDiffCommand creates a new DiffCommand with defaults.
+func NewDiffCommand(streams genericiooptions.IOStreams) *DiffCommand {
+	return &DiffCommand{
+		IO:           iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
+		OutputFormat: OutputFormatTable,
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *DiffCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatTable), flagDescDiffOutput)
+}
+
+// Complete populates Options and performs pre-validation setup.
+func (c *DiffCommand) Complete() error {
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (c *DiffCommand) Validate() error {
+	if c.OldReport == "" || c.NewReport == "" {
+		return errors.New("two report files are required: lint diff OLD NEW")
+	}
+
+	switch c.OutputFormat {
+	case OutputFormatTable, OutputFormatJSON:
+		return nil
+	case OutputFormatYAML, OutputFormatJUnit, OutputFormatSARIF, OutputFormatHTML:
+		return fmt.Errorf("unsupported output format for diff: %s (must be one of: table, json)", c.OutputFormat)
+	default:
+		return fmt.Errorf("invalid output format: %s (must be one of: table, json)", c.OutputFormat)
+	}
+}
+
+// Run loads both reports and prints their differences.
+func (c *DiffCommand) Run(_ context.Context) error {
+	oldList, err := LoadDiagnosticResultList(c.OldReport)
+	if err != nil {
+		return fmt.Errorf("loading old report: %w", err)
+	}
+
+	newList, err := LoadDiagnosticResultList(c.NewReport)
+	if err != nil {
+		return fmt.Errorf("loading new report: %w", err)
+	}
+
+	diff := DiffResultLists(oldList, newList)
+
+	switch c.OutputFormat {
+	case OutputFormatJSON:
+		renderer := printerjson.NewRenderer[*ReportDiff](
+			printerjson.WithWriter[*ReportDiff](c.IO.Out()),
+		)
+
+		if err := renderer.Render(diff); err != nil {
+			return fmt.Errorf("rendering JSON output: %w", err)
+		}
+
+		return nil
+	default:
+		return OutputDiffTable(c.IO.Out(), diff)
+	}
+}
+
+// diffTableRow is a single row of the diff summary table.
+type diffTableRow struct {
+	Change  string
+	Group   string
+	Kind    string
+	Check   string
+	Impact  string
+	Objects string
+}
+
+// OutputDiffTable prints a summary table of changed checks followed by the impacted
+// objects added or resolved for each of them.
+func OutputDiffTable(out io.Writer, diff *ReportDiff) error {
+	_, _ = fmt.Fprintf(out, "Comparing %s with %s\n\n",
+		describeReport(diff.OldClusterVersion, diff.OldTargetVersion),
+		describeReport(diff.NewClusterVersion, diff.NewTargetVersion))
+
+	if len(diff.Changes) == 0 {
+		_, _ = fmt.Fprintln(out, "No changes between reports.")
+
+		return nil
+	}
+
+	renderer := table.NewRenderer[diffTableRow](
+		table.WithWriter[diffTableRow](out),
+		table.WithHeaders[diffTableRow](diffTableHeaders...),
+		table.WithTableOptions[diffTableRow](table.DefaultTableOptions...),
+	)
+
+	counts := make(map[ChangeType]int)
+
+	for _, d := range diff.Changes {
+		counts[d.Change]++
+
+		row := diffTableRow{
+			Change:  string(d.Change),
+			Group:   d.Group,
+			Kind:    d.Kind,
+			Check:   d.Name,
+			Impact:  describeImpactChange(d),
+			Objects: fmt.Sprintf("+%d / -%d", len(d.AddedObjects), len(d.ResolvedObjects)),
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("appending table row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering table: %w", err)
+	}
+
+	for _, d := range diff.Changes {
+		if len(d.AddedObjects) == 0 && len(d.ResolvedObjects) == 0 {
+			continue
+		}
+
+		_, _ = fmt.Fprintf(out, "\n%s / %s / %s:\n", d.Group, d.Kind, d.Name)
+
+		for _, obj := range d.AddedObjects {
+			_, _ = fmt.Fprintf(out, "  + %s\n", obj)
+		}
+
+		for _, obj := range d.ResolvedObjects {
+			_, _ = fmt.Fprintf(out, "  - %s\n", obj)
+		}
+	}
+
+	_, _ = fmt.Fprintln(out)
+	_, _ = fmt.Fprintln(out, "Summary:")
+	_, _ = fmt.Fprintf(out, "  Newly failing: %d | Newly passing: %d | Impact changed: %d | Objects changed: %d | Added: %d | Removed: %d\n",
+		counts[ChangeNewlyFailing], counts[ChangeNewlyPassing], counts[ChangeImpactChanged],
+		counts[ChangeObjectsChanged], counts[ChangeAdded], counts[ChangeRemoved])
+
+	return nil
+}
+
+func describeReport(clusterVersion *string, targetVersion *string) string {
+	var b strings.Builder
+
+	b.WriteString("report")
+
+	if clusterVersion != nil && *clusterVersion != "" {
+		b.WriteString(" of " + *clusterVersion)
+	}
+
+	if targetVersion != nil && *targetVersion != "" {
+		b.WriteString(" -> " + *targetVersion)
+	}
+
+	return b.String()
+}
+
+// describeImpactChange renders "old -> new" using the table's severity labels.
+func describeImpactChange(d CheckDiff) string {
+	label := func(status string, impact result.Impact) string {
+		if status == "" {
+			return "-"
+		}
+
+		return severityLabel(impact)
+	}
+
+	oldLabel := label(d.OldStatus, d.OldImpact)
+	newLabel := label(d.NewStatus, d.NewImpact)
+
+	if oldLabel == newLabel {
+		return newLabel
+	}
+
+	return oldLabel + " -> " + newLabel
+}
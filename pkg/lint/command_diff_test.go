@@ -0,0 +1,117 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+// writeReport saves results the way `lint -o json` does and returns the file path.
+func writeReport(t *testing.T, name string, results ...*result.DiagnosticResult) string {
+	t.Helper()
+
+	executions := make([]check.CheckExecution, 0, len(results))
+	for _, r := range results {
+		executions = append(executions, check.CheckExecution{Result: r})
+	}
+
+	clusterVersion := "2.25.0"
+	targetVersion := "3.0.0"
+
+	var buf bytes.Buffer
+	if err := lint.OutputJSON(&buf, executions, &clusterVersion, &targetVersion, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestDiffCommand(t *testing.T) {
+	oldReport := writeReport(t, "old.json",
+		diffResult("notebook", result.ImpactNone),
+		diffResult("ray", result.ImpactBlocking, "rc-1"),
+	)
+	newReport := writeReport(t, "new.json",
+		diffResult("notebook", result.ImpactAdvisory, "nb-1"),
+		diffResult("ray", result.ImpactBlocking, "rc-2"),
+	)
+
+	run := func(g Gomega, format lint.OutputFormat) string {
+		var out bytes.Buffer
+
+		command := lint.NewDiffCommand(genericiooptions.IOStreams{
+			In:     &bytes.Buffer{},
+			Out:    &out,
+			ErrOut: &bytes.Buffer{},
+		})
+		command.OldReport = oldReport
+		command.NewReport = newReport
+		command.OutputFormat = format
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		return out.String()
+	}
+
+	t.Run("table output lists changes and objects", func(t *testing.T) {
+		g := NewWithT(t)
+
+		output := run(g, lint.OutputFormatTable)
+		g.Expect(output).To(ContainSubstring("Comparing report of 2.25.0 -> 3.0.0 with report of 2.25.0 -> 3.0.0"))
+		g.Expect(output).To(ContainSubstring("newly-failing"))
+		g.Expect(output).To(ContainSubstring("info -> warning"))
+		g.Expect(output).To(ContainSubstring("objects-changed"))
+		g.Expect(output).To(ContainSubstring("  + Notebook/ns1/rc-2"))
+		g.Expect(output).To(ContainSubstring("  - Notebook/ns1/rc-1"))
+		g.Expect(output).To(ContainSubstring("Newly failing: 1 | Newly passing: 0 | Impact changed: 0 | Objects changed: 1"))
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var diff lint.ReportDiff
+		g.Expect(json.Unmarshal([]byte(run(g, lint.OutputFormatJSON)), &diff)).To(Succeed())
+		g.Expect(diff.Changes).To(HaveLen(2))
+		g.Expect(diff.Changes[0].Change).To(Equal(lint.ChangeNewlyFailing))
+		g.Expect(diff.Changes[0].NewImpact).To(Equal(result.ImpactAdvisory))
+		g.Expect(diff.Changes[1].AddedObjects).To(Equal([]string{"Notebook/ns1/rc-2"}))
+	})
+
+	t.Run("rejects unsupported output format", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := lint.NewDiffCommand(genericiooptions.IOStreams{})
+		command.OldReport = oldReport
+		command.NewReport = newReport
+		command.OutputFormat = lint.OutputFormatHTML
+
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("unsupported output format")))
+	})
+
+	t.Run("fails on unreadable report", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := lint.NewDiffCommand(genericiooptions.IOStreams{Out: &bytes.Buffer{}})
+		command.OldReport = filepath.Join(t.TempDir(), "missing.json")
+		command.NewReport = newReport
+
+		g.Expect(command.Run(t.Context())).To(MatchError(ContainSubstring("loading old report")))
+	})
+}
@@ -0,0 +1,105 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+)
+
+// Verify ExplainCommand implements cmd.Command interface at compile time.
+var _ cmd.Command = (*ExplainCommand)(nil)
+
+// ExplainCommand prints the documentation of a single check: its description, remediation
+// and the conditions it can report. It does not connect to a cluster.
+type ExplainCommand struct {
+	*SharedOptions
+
+	// CheckID is the ID of the check to explain.
+	CheckID string
+
+	// registry is the check registry for this command instance.
+	registry *check.CheckRegistry
+}
+
+// NewExplainCommand creates a new ExplainCommand with defaults.
+func NewExplainCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+) *ExplainCommand {
+	return &ExplainCommand{
+		SharedOptions: NewSharedOptions(streams, configFlags),
+		registry:      newCheckRegistry(),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *ExplainCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatTable), flagDescCatalogOutput)
+	fs.StringVar(&c.CheckDir, "check-dir", "", flagDescCheckDir)
+}
+
+// Complete registers the declarative checks of CheckDir and the check plugins on PATH.
+func (c *ExplainCommand) Complete() error {
+	if err := registerCheckDir(c.registry, c.CheckDir); err != nil {
+		return err
+	}
+
+	return registerPlugins(c.registry, c.IO)
+}
+
+// Validate checks that all required options are valid.
+func (c *ExplainCommand) Validate() error {
+	if c.CheckID == "" {
+		return errors.New("check ID is required")
+	}
+
+	if !slices.Contains([]OutputFormat{OutputFormatTable, OutputFormatJSON, OutputFormatYAML}, c.OutputFormat) {
+		return fmt.Errorf("unsupported output format for lint explain: %s (must be one of: table, json, yaml)", c.OutputFormat)
+	}
+
+	return nil
+}
+
+// Run prints the documentation of the check.
+func (c *ExplainCommand) Run(_ context.Context) error {
+	chk, ok := c.registry.Get(c.CheckID)
+	if !ok {
+		return fmt.Errorf("unknown check %q (run 'lint list' to see the available checks)", c.CheckID)
+	}
+
+	explanation := NewCheckExplanation(chk)
+
+	switch c.OutputFormat {
+	case OutputFormatJSON:
+		renderer := printerjson.NewRenderer[CheckExplanation](
+			printerjson.WithWriter[CheckExplanation](c.IO.Out()),
+		)
+
+		if err := renderer.Render(explanation); err != nil {
+			return fmt.Errorf("rendering JSON output: %w", err)
+		}
+	case OutputFormatYAML:
+		renderer := printeryaml.NewRenderer[CheckExplanation](
+			printeryaml.WithWriter[CheckExplanation](c.IO.Out()),
+		)
+
+		if err := renderer.Render(explanation); err != nil {
+			return fmt.Errorf("rendering YAML output: %w", err)
+		}
+	default:
+		OutputCheckExplanation(c.IO.Out(), explanation)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestExplainCommand(t *testing.T) {
+	t.Run("should print the conditions and impact levels of a check", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+		command := lint.NewExplainCommand(genericiooptions.IOStreams{
+			In:     &bytes.Buffer{},
+			Out:    &out,
+			ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+		command.CheckID = "components.kserve.servicemesh-removal"
+		command.OutputFormat = lint.OutputFormatJSON
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		var explanation lint.CheckExplanation
+		g.Expect(json.Unmarshal(out.Bytes(), &explanation)).To(Succeed())
+		g.Expect(explanation.Remediation).ToNot(BeEmpty())
+		g.Expect(explanation.Conditions).To(ContainElement(check.ConditionSpec{
+			Reason: check.ReasonVersionIncompatible,
+			Impact: result.ImpactBlocking,
+		}))
+		g.Expect(explanation.Impacts).To(Equal([]result.Impact{result.ImpactBlocking, result.ImpactAdvisory}))
+	})
+
+	t.Run("should print a human-readable explanation", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var out bytes.Buffer
+		command := lint.NewExplainCommand(genericiooptions.IOStreams{
+			In:     &bytes.Buffer{},
+			Out:    &out,
+			ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+		command.CheckID = "components.kserve.servicemesh-removal"
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		g.Expect(out.String()).To(ContainSubstring("Remediation:"))
+		g.Expect(out.String()).To(MatchRegexp(`VersionCompatible\s+none`))
+		g.Expect(out.String()).To(MatchRegexp(`VersionIncompatible\s+blocking`))
+	})
+
+	t.Run("should fail for an unknown check", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := lint.NewExplainCommand(genericiooptions.NewTestIOStreamsDiscard(), testConfigFlags())
+		command.CheckID = "components.acme.unknown"
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(MatchError(ContainSubstring(`unknown check "components.acme.unknown"`)))
+	})
+}
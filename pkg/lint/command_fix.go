@@ -0,0 +1,223 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/blang/semver/v4"
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/confirmation"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Verify FixCommand implements cmd.Command interface at compile time.
+var _ cmd.Command = (*FixCommand)(nil)
+
+// FixCommand runs the lint checks and collects remediation patches from checks that
+// implement check.Fixer. The patches are printed as a kubectl script, or applied to the
+// cluster after confirmation when Apply is set.
+type FixCommand struct {
+	*SharedOptions
+
+	// TargetVersion is the optional upgrade target the checks are evaluated against.
+	// If empty, the checks applicable to the current installation are used.
+	TargetVersion string
+
+	// Apply patches the cluster after confirmation instead of printing the patches.
+	Apply bool
+
+	// parsedTargetVersion is the parsed semver version
+	parsedTargetVersion *semver.Version
+
+	// registry is the check registry for this command instance.
+	registry *check.CheckRegistry
+}
+
+// NewFixCommand creates a new FixCommand with defaults.
+func NewFixCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+) *FixCommand {
+	return &FixCommand{
+		SharedOptions: NewSharedOptions(streams, configFlags),
+		registry:      newCheckRegistry(),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *FixCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.TargetVersion, "target-version", "", flagDescTargetVersion)
+	fs.BoolVar(&c.Apply, "apply", false, flagDescFixApply)
+	fs.StringArrayVar(&c.CheckSelectors, "checks", []string{"*"}, flagDescChecks)
+	fs.BoolVarP(&c.Verbose, "verbose", "v", false, flagDescVerbose)
+	fs.BoolVar(&c.Debug, "debug", false, flagDescDebug)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescTimeout)
+	fs.IntVar(&c.Parallelism, "parallelism", c.Parallelism, flagDescParallelism)
+	fs.StringArrayVar(&c.CheckTimeouts, "check-timeout", nil, flagDescCheckTimeout)
+	fs.StringVar(&c.FromSnapshot, "from-snapshot", "", flagDescFromSnapshot)
+	fs.StringVar(&c.CheckDir, "check-dir", "", flagDescCheckDir)
+	fs.StringVar(&c.NamespaceSelector, "namespace-selector", "", flagDescNamespaceSelector)
+	fs.StringArrayVar(&c.ExcludeNamespaces, "exclude-namespace", nil, flagDescExcludeNamespace)
+
+	// Throttling settings
+	fs.Float32Var(&c.QPS, "qps", c.QPS, flagDescQPS)
+	fs.IntVar(&c.Burst, "burst", c.Burst, flagDescBurst)
+}
+
+// Complete populates Options and performs pre-validation setup.
+func (c *FixCommand) Complete() error {
+	if err := c.SharedOptions.Complete(); err != nil {
+		return fmt.Errorf("completing shared options: %w", err)
+	}
+
+	if !c.Verbose && !c.Debug {
+		c.IO = iostreams.NewQuietWrapper(c.IO)
+	}
+
+	if c.TargetVersion != "" {
+		// Use ParseTolerant to accept partial versions (e.g., "3.0" → "3.0.0")
+		targetVer, err := semver.ParseTolerant(c.TargetVersion)
+		if err != nil {
+			return fmt.Errorf("invalid target version %q: %w", c.TargetVersion, err)
+		}
+		c.parsedTargetVersion = &targetVer
+	}
+
+	if err := registerCheckDir(c.registry, c.CheckDir); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (c *FixCommand) Validate() error {
+	if err := c.SharedOptions.Validate(); err != nil {
+		return fmt.Errorf("validating shared options: %w", err)
+	}
+
+	if c.Apply && c.FromSnapshot != "" {
+		return errors.New("--apply cannot be used with --from-snapshot")
+	}
+
+	return nil
+}
+
+// Run executes the selected checks and prints or applies the patches of fixable findings.
+func (c *FixCommand) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	reader := client.NewCachedReader(c.Reader)
+
+	currentVersion, err := version.Detect(ctx, reader)
+	if err != nil {
+		return fmt.Errorf("detecting cluster version: %w", err)
+	}
+
+	// Without a target version, fix the findings of the current installation (lint mode).
+	targetVersion := currentVersion
+	if c.parsedTargetVersion != nil {
+		targetVersion = c.parsedTargetVersion
+	}
+
+	scope, err := c.NamespaceScope()
+	if err != nil {
+		return err
+	}
+
+	namespaces, err := scope.Resolve(ctx, reader)
+	if err != nil {
+		return fmt.Errorf("resolving namespace scope: %w", err)
+	}
+
+	checkTarget := check.Target{
+		Client:         reader,
+		CurrentVersion: currentVersion,
+		TargetVersion:  targetVersion,
+		Namespaces:     namespaces,
+		IO:             c.IO,
+		Debug:          c.Debug,
+	}
+
+	opts, err := c.executorOptions(c.registry)
+	if err != nil {
+		return err
+	}
+
+	executor := check.NewExecutor(c.registry, c.IO, opts...)
+
+	var executions []check.CheckExecution
+
+	for _, group := range check.CanonicalGroupOrder {
+		results, err := executor.ExecuteSelective(ctx, checkTarget, c.CheckSelectors, group)
+		if err != nil {
+			return fmt.Errorf("executing %s checks: %w", group, err)
+		}
+
+		executions = append(executions, results...)
+	}
+
+	fixes, err := CollectFixes(ctx, checkTarget, executions)
+	if err != nil {
+		return err
+	}
+
+	if len(fixes) == 0 {
+		c.IO.Fprintln("No fixable findings.")
+
+		return nil
+	}
+
+	if !c.Apply {
+		return WriteFixBundle(c.IO.Out(), fixes)
+	}
+
+	return c.applyFixes(ctx, fixes)
+}
+
+// applyFixes lists the patches, asks for confirmation and applies them. Every patch is
+// attempted; failures are reported together.
+func (c *FixCommand) applyFixes(ctx context.Context, fixes []Fix) error {
+	out := c.IO.Out()
+
+	_, _ = fmt.Fprintln(out, "The following patches will be applied:")
+
+	for _, f := range fixes {
+		_, _ = fmt.Fprintf(out, "  %s: %s (%s)\n", fixObjectRef(f), f.Description, f.CheckID)
+	}
+
+	if !confirmation.Prompt(c.IO, fmt.Sprintf("Apply %d patch(es) to the cluster?", len(fixes))) {
+		_, _ = fmt.Fprintln(out, "Aborted, no changes were made.")
+
+		return nil
+	}
+
+	var errs []error
+
+	for _, f := range fixes {
+		if err := ApplyFix(ctx, c.Client, f); err != nil {
+			errs = append(errs, err)
+			_, _ = fmt.Fprintf(out, "  ✗ %s: %v\n", fixObjectRef(f), err)
+
+			continue
+		}
+
+		_, _ = fmt.Fprintf(out, "  ✓ %s patched\n", fixObjectRef(f))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%d of %d patch(es) failed: %w", len(errs), len(fixes), errors.Join(errs...))
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Verify ListCommand implements cmd.Command interface at compile time.
+var _ cmd.Command = (*ListCommand)(nil)
+
+// ListCommand prints the registered checks. When a cluster is reachable, it also reports
+// whether each check applies to the installed version or the upgrade to TargetVersion.
+type ListCommand struct {
+	*SharedOptions
+
+	// TargetVersion is the optional upgrade target applicability is evaluated against.
+	// If empty, applicability is evaluated for the current installation.
+	TargetVersion string
+
+	// parsedTargetVersion is the parsed semver version
+	parsedTargetVersion *semver.Version
+
+	// registry is the check registry for this command instance.
+	registry *check.CheckRegistry
+}
+
+// NewListCommand creates a new ListCommand with defaults.
+func NewListCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+) *ListCommand {
+	return &ListCommand{
+		SharedOptions: NewSharedOptions(streams, configFlags),
+		registry:      newCheckRegistry(),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *ListCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.TargetVersion, "target-version", "", flagDescListTargetVersion)
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatTable), flagDescCatalogOutput)
+	fs.StringArrayVar(&c.CheckSelectors, "checks", []string{"*"}, flagDescChecks)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescTimeout)
+	fs.StringVar(&c.FromSnapshot, "from-snapshot", "", flagDescFromSnapshot)
+	fs.StringVar(&c.CheckDir, "check-dir", "", flagDescCheckDir)
+}
+
+// Complete populates Options and performs pre-validation setup. Failing to connect to a
+// cluster is not an error: the checks are then listed without applicability.
+func (c *ListCommand) Complete() error {
+	if err := c.SharedOptions.Complete(); err != nil {
+		if c.FromSnapshot != "" {
+			return fmt.Errorf("completing shared options: %w", err)
+		}
+
+		c.IO.Errorf("Warning: No cluster available, applicability is not evaluated: %v", err)
+	}
+
+	if c.TargetVersion != "" {
+		// Use ParseTolerant to accept partial versions (e.g., "3.0" → "3.0.0")
+		targetVer, err := semver.ParseTolerant(c.TargetVersion)
+		if err != nil {
+			return fmt.Errorf("invalid target version %q: %w", c.TargetVersion, err)
+		}
+		c.parsedTargetVersion = &targetVer
+	}
+
+	if err := registerCheckDir(c.registry, c.CheckDir); err != nil {
+		return err
+	}
+
+	// Plugins connect to the cluster themselves, so they cannot lint a snapshot.
+	if c.FromSnapshot == "" {
+		return registerPlugins(c.registry, c.IO)
+	}
+
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (c *ListCommand) Validate() error {
+	if !slices.Contains([]OutputFormat{OutputFormatTable, OutputFormatJSON, OutputFormatYAML}, c.OutputFormat) {
+		return fmt.Errorf("unsupported output format for lint list: %s (must be one of: table, json, yaml)", c.OutputFormat)
+	}
+
+	if err := ValidateCheckSelectors(c.CheckSelectors); err != nil {
+		return fmt.Errorf("validating check selectors: %w", err)
+	}
+
+	return nil
+}
+
+// Run prints the selected checks sorted by ID.
+func (c *ListCommand) Run(ctx context.Context) error {
+	checks, err := c.registry.ListByPatterns(c.CheckSelectors, "")
+	if err != nil {
+		return fmt.Errorf("selecting checks: %w", err)
+	}
+
+	slices.SortFunc(checks, func(a check.Check, b check.Check) int {
+		return strings.Compare(a.ID(), b.ID())
+	})
+
+	infos := make([]CheckInfo, 0, len(checks))
+	for _, chk := range checks {
+		infos = append(infos, NewCheckInfo(chk))
+	}
+
+	evaluated := c.Reader != nil && c.evaluateApplicability(ctx, checks, infos)
+
+	switch c.OutputFormat {
+	case OutputFormatJSON:
+		renderer := printerjson.NewRenderer[[]CheckInfo](
+			printerjson.WithWriter[[]CheckInfo](c.IO.Out()),
+		)
+
+		if err := renderer.Render(infos); err != nil {
+			return fmt.Errorf("rendering JSON output: %w", err)
+		}
+
+		return nil
+	case OutputFormatYAML:
+		renderer := printeryaml.NewRenderer[[]CheckInfo](
+			printeryaml.WithWriter[[]CheckInfo](c.IO.Out()),
+		)
+
+		if err := renderer.Render(infos); err != nil {
+			return fmt.Errorf("rendering YAML output: %w", err)
+		}
+
+		return nil
+	default:
+		return OutputCheckInfoTable(c.IO.Out(), infos, evaluated)
+	}
+}
+
+// evaluateApplicability sets Applies on infos by calling CanApply of each check. It
+// returns false without changes when the cluster version cannot be detected.
+func (c *ListCommand) evaluateApplicability(ctx context.Context, checks []check.Check, infos []CheckInfo) bool {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	reader := client.NewCachedReader(c.Reader)
+
+	currentVersion, err := version.Detect(ctx, reader)
+	if err != nil {
+		c.IO.Errorf("Warning: Failed to detect cluster version, applicability is not evaluated: %v", err)
+
+		return false
+	}
+
+	// Without a target version, evaluate the checks of the current installation (lint mode).
+	targetVersion := currentVersion
+	if c.parsedTargetVersion != nil {
+		targetVersion = c.parsedTargetVersion
+	}
+
+	target := check.Target{
+		Client:         reader,
+		CurrentVersion: currentVersion,
+		TargetVersion:  targetVersion,
+		IO:             c.IO,
+	}
+
+	for i, chk := range checks {
+		applies, err := chk.CanApply(ctx, target)
+		if err != nil {
+			c.IO.Errorf("Warning: Failed to evaluate applicability of %s: %v", chk.ID(), err)
+
+			continue
+		}
+
+		infos[i].Applies = &applies
+	}
+
+	return true
+}
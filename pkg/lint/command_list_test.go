@@ -0,0 +1,85 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestListCommand(t *testing.T) {
+	t.Run("should report applicability against a snapshot", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(snapshotDSC), 0o600)).To(Succeed())
+
+		var out bytes.Buffer
+		command := lint.NewListCommand(genericiooptions.IOStreams{
+			In:     &bytes.Buffer{},
+			Out:    &out,
+			ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+		command.FromSnapshot = dir
+		command.TargetVersion = "3.3"
+		command.CheckSelectors = []string{"components.kserve.*"}
+		command.OutputFormat = lint.OutputFormatJSON
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		var infos []lint.CheckInfo
+		g.Expect(json.Unmarshal(out.Bytes(), &infos)).To(Succeed())
+		g.Expect(infos).ToNot(BeEmpty())
+
+		applies := make(map[string]bool, len(infos))
+		for _, info := range infos {
+			g.Expect(info.Kind).To(Equal("kserve"))
+			g.Expect(info.Applies).ToNot(BeNil())
+			applies[info.ID] = *info.Applies
+		}
+
+		g.Expect(applies).To(HaveKeyWithValue("components.kserve.servicemesh-removal", true))
+	})
+
+	t.Run("should list checks without applicability when no cluster is available", func(t *testing.T) {
+		g := NewWithT(t)
+
+		configFlags := genericclioptions.NewConfigFlags(true)
+		kubeconfig := filepath.Join(t.TempDir(), "missing")
+		configFlags.KubeConfig = &kubeconfig
+
+		var out, errOut bytes.Buffer
+		command := lint.NewListCommand(genericiooptions.IOStreams{
+			In:     &bytes.Buffer{},
+			Out:    &out,
+			ErrOut: &errOut,
+		}, configFlags)
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		g.Expect(errOut.String()).To(ContainSubstring("applicability is not evaluated"))
+		g.Expect(out.String()).To(ContainSubstring("components.kserve.servicemesh-removal"))
+		g.Expect(out.String()).ToNot(ContainSubstring("APPLIES"))
+	})
+
+	t.Run("should reject unsupported output formats", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := lint.NewListCommand(genericiooptions.NewTestIOStreamsDiscard(), testConfigFlags())
+		command.OutputFormat = lint.OutputFormatJUnit
+
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("unsupported output format")))
+	})
+}
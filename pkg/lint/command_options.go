@@ -6,6 +6,7 @@ import (
 	"io"
 	"path"
 	"sort"
+	"strings"
 	"time"
 
 	"k8s.io/cli-runtime/pkg/genericclioptions"
@@ -15,6 +16,7 @@ import (
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
 	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+	"github.com/opendatahub-io/odh-cli/pkg/snapshot"
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
 )
@@ -26,9 +28,18 @@ const (
 	OutputFormatTable OutputFormat = "table"
 	OutputFormatJSON  OutputFormat = "json"
 	OutputFormatYAML  OutputFormat = "yaml"
+	OutputFormatJUnit OutputFormat = "junit"
+	OutputFormatSARIF OutputFormat = "sarif"
+	OutputFormatHTML  OutputFormat = "html"
 
 	// DefaultTimeout is the default timeout for lint commands.
 	DefaultTimeout = 5 * time.Minute
+
+	// DefaultParallelism runs checks sequentially unless --parallelism is set.
+	DefaultParallelism = 1
+
+	// DefaultCheckTimeout is the default deadline of a single check.
+	DefaultCheckTimeout = 2 * time.Minute
 )
 
 // SeverityLevel represents the minimum severity threshold for display filtering.
@@ -42,13 +53,27 @@ const (
 	SeverityLevelInfo       SeverityLevel = "info"       // Show all conditions (default)
 )
 
+// FailOn selects the lowest finding category that makes lint exit with a non-zero code.
+// Categories are ordered: prohibited, blocking, advisory, error; each level also fails
+// on the categories before it. "error" additionally fails when a check could not be
+// executed, and "none" never fails on findings.
+type FailOn string
+
+const (
+	FailOnProhibited FailOn = "prohibited" // Fail on prohibited findings (default)
+	FailOnBlocking   FailOn = "blocking"   // Fail on prohibited and blocking findings
+	FailOnAdvisory   FailOn = "advisory"   // Fail on any finding
+	FailOnError      FailOn = "error"      // Fail on any finding or check execution error
+	FailOnNone       FailOn = "none"       // Never fail on findings
+)
+
 // Validate checks if the output format is valid.
 func (o OutputFormat) Validate() error {
 	switch o {
-	case OutputFormatTable, OutputFormatJSON, OutputFormatYAML:
+	case OutputFormatTable, OutputFormatJSON, OutputFormatYAML, OutputFormatJUnit, OutputFormatSARIF, OutputFormatHTML:
 		return nil
 	default:
-		return fmt.Errorf("invalid output format: %s (must be one of: table, json, yaml)", o)
+		return fmt.Errorf("invalid output format: %s (must be one of: table, json, yaml, junit, sarif, html)", o)
 	}
 }
 
@@ -62,6 +87,16 @@ func (s SeverityLevel) Validate() error {
 	}
 }
 
+// Validate checks if the fail-on policy is valid.
+func (f FailOn) Validate() error {
+	switch f {
+	case FailOnProhibited, FailOnBlocking, FailOnAdvisory, FailOnError, FailOnNone:
+		return nil
+	default:
+		return fmt.Errorf("invalid fail-on value: %s (must be one of: prohibited, blocking, advisory, error, none)", f)
+	}
+}
+
 // SharedOptions contains options common to all lint subcommands.
 type SharedOptions struct {
 	// IO provides structured access to stdin, stdout, stderr with convenience methods
@@ -70,7 +105,7 @@ type SharedOptions struct {
 	// ConfigFlags provides access to kubeconfig and context
 	ConfigFlags *genericclioptions.ConfigFlags
 
-	// OutputFormat specifies the output format (table, json, yaml)
+	// OutputFormat specifies the output format (table, json, yaml, junit, sarif, html)
 	OutputFormat OutputFormat
 
 	// CheckSelectors filters which checks to run (glob patterns, repeatable)
@@ -89,9 +124,34 @@ type SharedOptions struct {
 	// Timeout is the maximum duration for command execution
 	Timeout time.Duration
 
-	// Client is the Kubernetes client (populated during Complete)
+	// Parallelism is the maximum number of checks run concurrently within a check group
+	Parallelism int
+
+	// CheckTimeouts holds the --check-timeout values: a duration sets the deadline of every
+	// check, and CHECK_ID=DURATION overrides it for one check (repeatable)
+	CheckTimeouts []string
+
+	// FromSnapshot is a directory of captured cluster objects to lint instead of a live cluster
+	FromSnapshot string
+
+	// CheckDir is a directory of declarative check definitions registered in addition
+	// to the built-in checks
+	CheckDir string
+
+	// NamespaceSelector limits workload checks to namespaces matching this label selector.
+	// Workload checks are also limited to the namespaces of the --namespace flag, if set.
+	NamespaceSelector string
+
+	// ExcludeNamespaces lists namespaces left out of workload checks
+	ExcludeNamespaces []string
+
+	// Client is the Kubernetes client (populated during Complete, nil when linting a snapshot)
 	Client client.Client
 
+	// Reader is the read-only view checks run against: Client, or the snapshot reader
+	// when FromSnapshot is set (populated during Complete)
+	Reader client.Reader
+
 	// Throttling settings for Kubernetes API client
 	QPS   float32
 	Burst int
@@ -109,6 +169,7 @@ func NewSharedOptions(
 		CheckSelectors: []string{"*"},     // Run all checks by default
 		SeverityLevel:  SeverityLevelInfo, // Show all severity levels by default
 		Timeout:        DefaultTimeout,    // Default timeout to prevent hanging on slow clusters
+		Parallelism:    DefaultParallelism,
 		IO:             iostreams.NewIOStreams(streams.In, streams.Out, streams.ErrOut),
 		QPS:            client.DefaultQPS,
 		Burst:          client.DefaultBurst,
@@ -116,7 +177,19 @@ func NewSharedOptions(
 }
 
 // Complete populates the client and performs pre-validation setup.
+// When FromSnapshot is set, no cluster connection is made and Reader serves the snapshot.
 func (o *SharedOptions) Complete() error {
+	if o.FromSnapshot != "" {
+		r, err := snapshot.NewReader(o.FromSnapshot)
+		if err != nil {
+			return fmt.Errorf("failed to load snapshot: %w", err)
+		}
+
+		o.Reader = r
+
+		return nil
+	}
+
 	// Create REST config with user-specified throttling
 	restConfig, err := client.NewRESTConfig(o.ConfigFlags, o.QPS, o.Burst)
 	if err != nil {
@@ -130,6 +203,7 @@ func (o *SharedOptions) Complete() error {
 	}
 
 	o.Client = c
+	o.Reader = c
 
 	return nil
 }
@@ -156,9 +230,104 @@ func (o *SharedOptions) Validate() error {
 		return errors.New("timeout must be greater than 0")
 	}
 
+	// Validate parallelism
+	if o.Parallelism < 1 {
+		return errors.New("parallelism must be at least 1")
+	}
+
+	// Validate namespace scope
+	if _, err := o.NamespaceScope(); err != nil {
+		return err
+	}
+
+	// Validate check timeouts
+	if _, _, err := o.parseCheckTimeouts(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// parseCheckTimeouts returns the default check deadline and the per-check overrides of
+// CheckTimeouts. The last value given for a check wins.
+func (o *SharedOptions) parseCheckTimeouts() (time.Duration, map[string]time.Duration, error) {
+	timeout := DefaultCheckTimeout
+	overrides := make(map[string]time.Duration)
+
+	for _, value := range o.CheckTimeouts {
+		id, raw, isOverride := strings.Cut(value, "=")
+		if !isOverride {
+			raw = value
+		}
+
+		d, err := time.ParseDuration(strings.TrimSpace(raw))
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid check timeout %q: %w", value, err)
+		}
+
+		if d <= 0 {
+			return 0, nil, fmt.Errorf("invalid check timeout %q: must be greater than 0", value)
+		}
+
+		if !isOverride {
+			timeout = d
+
+			continue
+		}
+
+		if id = strings.TrimSpace(id); id == "" {
+			return 0, nil, fmt.Errorf("invalid check timeout %q: check ID is empty", value)
+		}
+
+		overrides[id] = d
+	}
+
+	return timeout, overrides, nil
+}
+
+// executorOptions returns the options of the check executor: parallelism and the check
+// deadlines. Overrides must name a check of registry, so that a typo is not ignored.
+func (o *SharedOptions) executorOptions(registry *check.CheckRegistry) ([]check.ExecutorOption, error) {
+	timeout, overrides, err := o.parseCheckTimeouts()
+	if err != nil {
+		return nil, err
+	}
+
+	for id := range overrides {
+		if _, ok := registry.Get(id); !ok {
+			return nil, fmt.Errorf("invalid check timeout: unknown check %q", id)
+		}
+	}
+
+	return []check.ExecutorOption{
+		check.WithParallelism(o.Parallelism),
+		check.WithCheckTimeout(timeout),
+		check.WithCheckTimeouts(overrides),
+	}, nil
+}
+
+// NamespaceScope returns the namespaces workload checks are limited to, from the
+// --namespace (comma-separated), --namespace-selector and --exclude-namespace flags.
+// Returns nil when workload checks run cluster-wide.
+func (o *SharedOptions) NamespaceScope() (*check.NamespaceScope, error) {
+	var namespaces []string
+
+	if o.ConfigFlags != nil && o.ConfigFlags.Namespace != nil {
+		for ns := range strings.SplitSeq(*o.ConfigFlags.Namespace, ",") {
+			if ns = strings.TrimSpace(ns); ns != "" {
+				namespaces = append(namespaces, ns)
+			}
+		}
+	}
+
+	scope, err := check.NewNamespaceScope(namespaces, o.NamespaceSelector, o.ExcludeNamespaces)
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace scope: %w", err)
+	}
+
+	return scope, nil
+}
+
 // ValidateCheckSelectors validates all check selector patterns.
 func ValidateCheckSelectors(selectors []string) error {
 	if len(selectors) == 0 {
@@ -292,7 +461,8 @@ func FilterBySeverity(results []check.CheckExecution, minLevel SeverityLevel) []
 
 		var kept []result.Condition
 		for _, cond := range exec.Result.Status.Conditions {
-			if meetsMinSeverity(cond.Impact, minLevel) {
+			// Checks that were not executed are always shown, since the run is incomplete.
+			if meetsMinSeverity(cond.Impact, minLevel) || check.IsNotExecuted(cond) {
 				kept = append(kept, cond)
 			}
 		}
@@ -411,6 +581,7 @@ type VersionInfo struct {
 	RHOAICurrentVersion string
 	RHOAITargetVersion  string // empty in lint mode
 	OpenShiftVersion    string
+	WorkloadScope       string // empty when workload checks ran cluster-wide
 }
 
 // TableOutputOptions configures the behavior of OutputTable.
@@ -426,22 +597,36 @@ type TableOutputOptions struct {
 	NamespaceRequesters map[string]string
 }
 
-// OutputJSON outputs diagnostic results in List format.
-func OutputJSON(
-	out io.Writer,
+// newDiagnosticResultList builds the List that every structured output format is rendered from,
+// with results in execution order.
+func newDiagnosticResultList(
 	results []check.CheckExecution,
 	clusterVersion *string,
 	targetVersion *string,
 	openShiftVersion *string,
-) error {
-	// Create the list
+) *result.DiagnosticResultList {
 	list := result.NewDiagnosticResultList(clusterVersion, targetVersion, openShiftVersion)
 
-	// Add all results in execution order
 	for _, exec := range results {
 		list.Results = append(list.Results, exec.Result)
 	}
 
+	return list
+}
+
+// OutputJSON outputs diagnostic results in List format.
+func OutputJSON(
+	out io.Writer,
+	results []check.CheckExecution,
+	clusterVersion *string,
+	targetVersion *string,
+	openShiftVersion *string,
+) error {
+	return renderJSONList(out, newDiagnosticResultList(results, clusterVersion, targetVersion, openShiftVersion))
+}
+
+// renderJSONList renders a diagnostic result list as JSON.
+func renderJSONList(out io.Writer, list *result.DiagnosticResultList) error {
 	renderer := printerjson.NewRenderer[*result.DiagnosticResultList](
 		printerjson.WithWriter[*result.DiagnosticResultList](out),
 	)
@@ -461,14 +646,11 @@ func OutputYAML(
 	targetVersion *string,
 	openShiftVersion *string,
 ) error {
-	// Create the list
-	list := result.NewDiagnosticResultList(clusterVersion, targetVersion, openShiftVersion)
-
-	// Add all results in execution order
-	for _, exec := range results {
-		list.Results = append(list.Results, exec.Result)
-	}
+	return renderYAMLList(out, newDiagnosticResultList(results, clusterVersion, targetVersion, openShiftVersion))
+}
 
+// renderYAMLList renders a diagnostic result list as YAML.
+func renderYAMLList(out io.Writer, list *result.DiagnosticResultList) error {
 	renderer := printeryaml.NewRenderer[*result.DiagnosticResultList](
 		printeryaml.WithWriter[*result.DiagnosticResultList](out),
 	)
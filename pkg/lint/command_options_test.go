@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
 
 	"github.com/opendatahub-io/odh-cli/pkg/lint"
 	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
@@ -367,3 +368,50 @@ func TestFilterBySeverity_NilResultSkipped(t *testing.T) {
 	g.Expect(filtered).To(HaveLen(1))
 	g.Expect(filtered[0].Result.Kind).To(Equal("kserve"))
 }
+
+func TestFilterBySeverity_KeepsNotExecuted(t *testing.T) {
+	g := NewWithT(t)
+
+	results := []check.CheckExecution{
+		makeExec("kserve", makeCondition(result.ImpactBlocking, "crit")),
+		notExecutedResult(),
+	}
+
+	filtered := lint.FilterBySeverity(results, lint.SeverityLevelProhibited)
+
+	g.Expect(filtered).To(HaveLen(1))
+	g.Expect(filtered[0].Result.Kind).To(Equal("kueue"))
+}
+
+func TestSharedOptions_ValidateCheckTimeouts(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeouts []string
+		wantErr  string
+	}{
+		{"default only", []string{"30s"}, ""},
+		{"default and overrides", []string{"30s", "workloads.kueue.data-integrity=5m"}, ""},
+		{"invalid duration", []string{"soon"}, `invalid check timeout "soon"`},
+		{"invalid override duration", []string{"workloads.kueue.data-integrity=5"}, "invalid check timeout"},
+		{"zero duration", []string{"0s"}, "must be greater than 0"},
+		{"empty check ID", []string{"=1m"}, "check ID is empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			opts := lint.NewSharedOptions(genericiooptions.NewTestIOStreamsDiscard(), testConfigFlags())
+			opts.CheckTimeouts = tt.timeouts
+
+			err := opts.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+
+				return
+			}
+
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+	"github.com/opendatahub-io/odh-cli/pkg/upgrade"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Verify PlanCommand implements cmd.Command interface at compile time.
+var _ cmd.Command = (*PlanCommand)(nil)
+
+// PlanCommand computes the supported upgrade path from the installed version to a target
+// version and runs the lint checks for every hop of the path.
+type PlanCommand struct {
+	*SharedOptions
+
+	// TargetVersion is the version to plan the upgrade to.
+	TargetVersion string
+
+	// FailOn selects the findings that make the command exit with a non-zero code.
+	FailOn FailOn
+
+	// WaiversFile is an optional path to a YAML file of waivers accepting known findings.
+	WaiversFile string
+
+	// waivers are loaded from WaiversFile during Complete
+	waivers []Waiver
+
+	// parsedTargetVersion is the parsed semver version
+	parsedTargetVersion *semver.Version
+
+	// graph holds the supported upgrade edges.
+	graph *upgrade.Graph
+
+	// registry is the check registry for this command instance.
+	registry *check.CheckRegistry
+}
+
+// NewPlanCommand creates a new PlanCommand with defaults.
+func NewPlanCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+) *PlanCommand {
+	return &PlanCommand{
+		SharedOptions: NewSharedOptions(streams, configFlags),
+		FailOn:        FailOnProhibited,
+		graph:         upgrade.Default(),
+		registry:      newCheckRegistry(),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *PlanCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.TargetVersion, "target-version", "", flagDescPlanTargetVersion)
+	fs.StringVarP((*string)(&c.OutputFormat), "output", "o", string(OutputFormatTable), flagDescPlanOutput)
+	fs.StringVar((*string)(&c.SeverityLevel), "severity", string(SeverityLevelInfo), flagDescSeverity)
+	fs.StringArrayVar(&c.CheckSelectors, "checks", []string{"*"}, flagDescChecks)
+	fs.BoolVarP(&c.Verbose, "verbose", "v", false, flagDescVerbose)
+	fs.BoolVar(&c.Debug, "debug", false, flagDescDebug)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescTimeout)
+	fs.IntVar(&c.Parallelism, "parallelism", c.Parallelism, flagDescParallelism)
+	fs.StringArrayVar(&c.CheckTimeouts, "check-timeout", nil, flagDescCheckTimeout)
+	fs.StringVar(&c.FromSnapshot, "from-snapshot", "", flagDescFromSnapshot)
+	fs.StringVar(&c.CheckDir, "check-dir", "", flagDescCheckDir)
+	fs.StringVar((*string)(&c.FailOn), "fail-on", string(FailOnProhibited), flagDescFailOn)
+	fs.StringVar(&c.WaiversFile, "waivers", "", flagDescWaivers)
+	fs.StringVar(&c.NamespaceSelector, "namespace-selector", "", flagDescNamespaceSelector)
+	fs.StringArrayVar(&c.ExcludeNamespaces, "exclude-namespace", nil, flagDescExcludeNamespace)
+
+	// Throttling settings
+	fs.Float32Var(&c.QPS, "qps", c.QPS, flagDescQPS)
+	fs.IntVar(&c.Burst, "burst", c.Burst, flagDescBurst)
+}
+
+// Complete populates Options and performs pre-validation setup.
+func (c *PlanCommand) Complete() error {
+	if err := c.SharedOptions.Complete(); err != nil {
+		return fmt.Errorf("completing shared options: %w", err)
+	}
+
+	if !c.Verbose && !c.Debug {
+		c.IO = iostreams.NewQuietWrapper(c.IO)
+	}
+
+	if c.TargetVersion != "" {
+		// Use ParseTolerant to accept partial versions (e.g., "3.0" → "3.0.0")
+		targetVer, err := semver.ParseTolerant(c.TargetVersion)
+		if err != nil {
+			return fmt.Errorf("invalid target version %q: %w", c.TargetVersion, err)
+		}
+		c.parsedTargetVersion = &targetVer
+	}
+
+	if err := registerCheckDir(c.registry, c.CheckDir); err != nil {
+		return err
+	}
+
+	// Plugins connect to the cluster themselves, so they cannot assess a snapshot.
+	if c.FromSnapshot == "" {
+		if err := registerPlugins(c.registry, c.IO); err != nil {
+			return err
+		}
+	}
+
+	if c.WaiversFile != "" {
+		waivers, err := LoadWaivers(c.WaiversFile)
+		if err != nil {
+			return fmt.Errorf("loading waivers: %w", err)
+		}
+		c.waivers = waivers
+	}
+
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (c *PlanCommand) Validate() error {
+	if err := c.SharedOptions.Validate(); err != nil {
+		return fmt.Errorf("validating shared options: %w", err)
+	}
+
+	if c.TargetVersion == "" {
+		return errors.New("--target-version is required")
+	}
+
+	if !slices.Contains([]OutputFormat{OutputFormatTable, OutputFormatJSON, OutputFormatYAML}, c.OutputFormat) {
+		return fmt.Errorf("unsupported output format for upgrade plan: %s (must be one of: table, json, yaml)", c.OutputFormat)
+	}
+
+	return c.FailOn.Validate()
+}
+
+// Run plans the upgrade path, assesses each hop and prints the findings per hop.
+func (c *PlanCommand) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	if c.FromSnapshot != "" {
+		c.IO.Errorf("Planning upgrade for cluster snapshot from %s", c.FromSnapshot)
+	}
+
+	reader := client.NewCachedReader(c.Reader)
+
+	currentVersion, err := version.Detect(ctx, reader)
+	if err != nil {
+		return fmt.Errorf("detecting cluster version: %w", err)
+	}
+
+	plan := &Plan{ClusterVersion: currentVersion.String()}
+
+	ocpVersion, err := version.DetectOpenShiftVersion(ctx, reader)
+	if err != nil {
+		c.IO.Errorf("Warning: Failed to detect OpenShift version: %v", err)
+	} else {
+		plan.OpenShiftVersion = ocpVersion.String()
+	}
+
+	scope, err := c.NamespaceScope()
+	if err != nil {
+		return err
+	}
+
+	namespaces, err := scope.Resolve(ctx, reader)
+	if err != nil {
+		return fmt.Errorf("resolving namespace scope: %w", err)
+	}
+
+	hops, err := c.graph.Plan(*currentVersion, *c.parsedTargetVersion)
+	if err != nil {
+		return fmt.Errorf("planning upgrade to %s: %w", c.TargetVersion, err)
+	}
+
+	cleanup, err := connectPlugins(c.registry, c.ConfigFlags)
+	if err != nil {
+		return err
+	}
+
+	defer cleanup()
+
+	opts, err := c.executorOptions(c.registry)
+	if err != nil {
+		return err
+	}
+
+	executor := check.NewExecutor(c.registry, c.IO, opts...)
+
+	// Waivers expire independently of the hop, so the expired ones are reported once.
+	now := time.Now()
+
+	var expired []Waiver
+
+	for _, hop := range hops {
+		c.IO.Errorf("Assessing hop %s → %s...", hop.From.String(), hop.To.String())
+
+		results, err := executeChecks(ctx, executor, check.Target{
+			Client:         reader,
+			CurrentVersion: &hop.From,
+			TargetVersion:  &hop.To,
+			Namespaces:     namespaces,
+			IO:             c.IO,
+			Debug:          c.Debug,
+		}, c.CheckSelectors)
+		if err != nil {
+			return fmt.Errorf("assessing hop %s → %s: %w", hop.From.String(), hop.To.String(), err)
+		}
+
+		if expired, err = ApplyWaivers(results, c.waivers, now); err != nil {
+			return fmt.Errorf("applying waivers: %w", err)
+		}
+
+		plan.Hops = append(plan.Hops, HopResult{
+			Hop:     hop,
+			Results: FilterBySeverity(results, c.SeverityLevel),
+		})
+	}
+
+	warnExpiredWaivers(c.IO, expired)
+
+	if err := c.outputPlan(plan); err != nil {
+		return err
+	}
+
+	findings := plan.Findings()
+
+	if c.OutputFormat == OutputFormatTable {
+		printVerdict(c.IO.Out(), findings)
+	}
+
+	return findings.Evaluate(c.FailOn)
+}
+
+// outputPlan writes the plan in the selected output format.
+func (c *PlanCommand) outputPlan(plan *Plan) error {
+	switch c.OutputFormat {
+	case OutputFormatJSON:
+		renderer := printerjson.NewRenderer[*PlanReport](
+			printerjson.WithWriter[*PlanReport](c.IO.Out()),
+		)
+
+		if err := renderer.Render(plan.Report()); err != nil {
+			return fmt.Errorf("rendering JSON output: %w", err)
+		}
+
+		return nil
+	case OutputFormatYAML:
+		renderer := printeryaml.NewRenderer[*PlanReport](
+			printeryaml.WithWriter[*PlanReport](c.IO.Out()),
+		)
+
+		if err := renderer.Render(plan.Report()); err != nil {
+			return fmt.Errorf("rendering YAML output: %w", err)
+		}
+
+		return nil
+	default:
+		return OutputPlanTable(c.IO.Out(), plan)
+	}
+}
@@ -0,0 +1,192 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/upgrade"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestPlanCommand(t *testing.T) {
+	t.Run("should assess every hop of the upgrade path", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		dsc := strings.Replace(snapshotDSC, "version: 2.25.0", "version: 2.16.1", 1)
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(dsc), 0o600)).To(Succeed())
+
+		var out bytes.Buffer
+		command := lint.NewPlanCommand(genericiooptions.IOStreams{
+			In:     &bytes.Buffer{},
+			Out:    &out,
+			ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+		command.FromSnapshot = dir
+		command.TargetVersion = "3.3"
+		command.OutputFormat = lint.OutputFormatJSON
+		command.FailOn = lint.FailOnNone
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		var report lint.PlanReport
+		g.Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+		g.Expect(report.ClusterVersion).To(Equal("2.16.1"))
+
+		hops := make([]string, 0, len(report.Hops))
+		for _, hop := range report.Hops {
+			hops = append(hops, hop.From+" → "+hop.To)
+		}
+
+		g.Expect(hops).To(Equal([]string{"2.16.1 → 2.22.0", "2.22.0 → 2.25.0", "2.25.0 → 3.3.0"}))
+		g.Expect(report.Hops[2].MinOpenShiftVersion).To(Equal("4.19.9"))
+	})
+
+	t.Run("should fail without a supported path", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(snapshotDSC), 0o600)).To(Succeed())
+
+		command := lint.NewPlanCommand(genericiooptions.NewTestIOStreamsDiscard(), testConfigFlags())
+		command.FromSnapshot = dir
+		command.TargetVersion = "2.22"
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(MatchError(ContainSubstring("planning upgrade to 2.22")))
+	})
+
+	t.Run("should not block hops with waived findings", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		dsc := strings.Replace(snapshotDSC, "version: 2.25.0", "version: 2.16.1", 1)
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(dsc), 0o600)).To(Succeed())
+
+		checkDir := t.TempDir()
+		blockingCheck := strings.Replace(dscPolicyCheck, "impact: advisory", "impact: blocking", 1)
+		g.Expect(os.WriteFile(filepath.Join(checkDir, "dsc-name.yaml"), []byte(blockingCheck), 0o600)).To(Succeed())
+
+		waivers := filepath.Join(t.TempDir(), "waivers.yaml")
+		g.Expect(os.WriteFile(waivers, []byte(`waivers:
+  - check: platform.dsc.named-default
+    reason: renamed in the next maintenance window
+    expires: "2099-12-31"
+  - check: "*"
+    reason: legacy exception
+    expires: "2020-01-31"
+`), 0o600)).To(Succeed())
+
+		newCommand := func(out *bytes.Buffer, errOut *bytes.Buffer) *lint.PlanCommand {
+			command := lint.NewPlanCommand(genericiooptions.IOStreams{
+				In:     &bytes.Buffer{},
+				Out:    out,
+				ErrOut: errOut,
+			}, testConfigFlags())
+			command.FromSnapshot = dir
+			command.CheckDir = checkDir
+			command.CheckSelectors = []string{"platform.dsc.named-default"}
+			command.TargetVersion = "3.3"
+			command.OutputFormat = lint.OutputFormatJSON
+			command.FailOn = lint.FailOnBlocking
+
+			return command
+		}
+
+		command := newCommand(&bytes.Buffer{}, &bytes.Buffer{})
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(HaveOccurred())
+
+		var out, errOut bytes.Buffer
+		command = newCommand(&out, &errOut)
+		command.WaiversFile = waivers
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		var report lint.PlanReport
+		g.Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+		g.Expect(report.Hops).To(HaveLen(3))
+		g.Expect(report.Hops).To(HaveEach(HaveField("Blocked", BeFalse())))
+
+		// The expired waiver is reported once, not for every hop.
+		g.Expect(strings.Count(errOut.String(), `waiver for "*" expired`)).To(Equal(1))
+	})
+
+	t.Run("should register check plugins found on PATH", func(t *testing.T) {
+		g := NewWithT(t)
+
+		pluginDir := t.TempDir()
+		pluginPath := filepath.Join(pluginDir, "odh-lint-check-workloads.kserve.not-ready")
+		g.Expect(os.WriteFile(pluginPath, []byte("#!/bin/sh\nexit 0\n"), 0o700)).To(Succeed())
+		t.Setenv("PATH", pluginDir)
+
+		command := lint.NewPlanCommand(genericiooptions.NewTestIOStreamsDiscard(), testConfigFlags())
+
+		// The plugin reuses the ID of an embedded declarative check
+		g.Expect(command.Complete()).To(MatchError(ContainSubstring("registering check plugin " + pluginPath)))
+	})
+
+	t.Run("should require a target version", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := lint.NewPlanCommand(genericiooptions.NewTestIOStreamsDiscard(), testConfigFlags())
+		command.FromSnapshot = t.TempDir()
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(MatchError("--target-version is required"))
+	})
+}
+
+func newHop(from string, to string, minOpenShift string, results ...*result.DiagnosticResult) lint.HopResult {
+	hop := lint.HopResult{
+		Hop: upgrade.Hop{
+			From:                semver.MustParse(from),
+			To:                  semver.MustParse(to),
+			MinOpenShiftVersion: semver.MustParse(minOpenShift),
+		},
+	}
+
+	for _, r := range results {
+		hop.Results = append(hop.Results, check.CheckExecution{Result: r})
+	}
+
+	return hop
+}
+
+func TestOutputPlanTable(t *testing.T) {
+	g := NewWithT(t)
+
+	plan := &lint.Plan{
+		ClusterVersion: "2.22.1",
+		Hops: []lint.HopResult{
+			newHop("2.22.1", "2.25.0", "4.16.0", diffResult("notebook", result.ImpactNone)),
+			newHop("2.25.0", "3.3.0", "4.19.9", diffResult("kserve", result.ImpactBlocking)),
+		},
+	}
+
+	var out bytes.Buffer
+	g.Expect(lint.OutputPlanTable(&out, plan)).To(Succeed())
+
+	output := out.String()
+	g.Expect(output).To(ContainSubstring("Upgrade path: 2.22.1 → 2.25.0 → 3.3.0"))
+	g.Expect(output).To(ContainSubstring("4.19.9+"))
+	g.Expect(output).To(ContainSubstring("Hop 2 (2.25.0 → 3.3.0):"))
+	g.Expect(output).ToNot(ContainSubstring("Hop 1 ("))
+}
@@ -0,0 +1,197 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+const (
+	// DefaultListenAddress is the default address of the lint serve endpoints.
+	DefaultListenAddress = ":8080"
+
+	// DefaultInterval is the default time between two lint serve runs.
+	DefaultInterval = time.Hour
+
+	// shutdownTimeout bounds the graceful shutdown of the lint serve endpoints.
+	shutdownTimeout = 10 * time.Second
+
+	// readHeaderTimeout bounds reading the request headers of the lint serve endpoints.
+	readHeaderTimeout = 10 * time.Second
+)
+
+// Verify ServeCommand implements cmd.Command interface at compile time.
+var _ cmd.Command = (*ServeCommand)(nil)
+
+// ServeCommand re-runs the lint checks on an interval and serves the outcome of the
+// latest run as Prometheus metrics and as a JSON report, until it is interrupted.
+type ServeCommand struct {
+	*Command
+
+	// ListenAddress is the address the endpoints listen on.
+	ListenAddress string
+
+	// Interval is the time between the start of two runs.
+	Interval time.Duration
+
+	// monitor holds the metrics and the report of the latest run.
+	monitor *Monitor
+}
+
+// NewServeCommand creates a new ServeCommand with defaults.
+func NewServeCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+) *ServeCommand {
+	return &ServeCommand{
+		Command:       NewCommand(streams, configFlags),
+		ListenAddress: DefaultListenAddress,
+		Interval:      DefaultInterval,
+		monitor:       NewMonitor(),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *ServeCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.TargetVersion, "target-version", "", flagDescTargetVersion)
+	fs.StringVar(&c.ListenAddress, "listen-address", c.ListenAddress, flagDescListenAddress)
+	fs.DurationVar(&c.Interval, "interval", c.Interval, flagDescInterval)
+	fs.StringVar((*string)(&c.SeverityLevel), "severity", string(SeverityLevelInfo), flagDescSeverity)
+	fs.StringArrayVar(&c.CheckSelectors, "checks", []string{"*"}, flagDescChecks)
+	fs.BoolVarP(&c.Verbose, "verbose", "v", false, flagDescVerbose)
+	fs.BoolVar(&c.Debug, "debug", false, flagDescDebug)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescTimeout)
+	fs.IntVar(&c.Parallelism, "parallelism", c.Parallelism, flagDescParallelism)
+	fs.StringArrayVar(&c.CheckTimeouts, "check-timeout", nil, flagDescCheckTimeout)
+	fs.StringVar(&c.ISVCDeploymentMode, "isvc-deployment-mode", "all", flagDescISVCDeploymentMode)
+	fs.StringVar(&c.CheckDir, "check-dir", "", flagDescCheckDir)
+	fs.StringVar(&c.WaiversFile, "waivers", "", flagDescWaivers)
+	fs.BoolVar(&c.Publish, "publish", false, flagDescServePublish)
+	fs.StringVar(&c.NamespaceSelector, "namespace-selector", "", flagDescNamespaceSelector)
+	fs.StringArrayVar(&c.ExcludeNamespaces, "exclude-namespace", nil, flagDescExcludeNamespace)
+
+	// Throttling settings
+	fs.Float32Var(&c.QPS, "qps", c.QPS, flagDescQPS)
+	fs.IntVar(&c.Burst, "burst", c.Burst, flagDescBurst)
+}
+
+// Validate checks that all required options are valid.
+func (c *ServeCommand) Validate() error {
+	if err := c.Command.Validate(); err != nil {
+		return err
+	}
+
+	if c.Interval <= 0 {
+		return fmt.Errorf("--interval must be positive, got %s", c.Interval)
+	}
+
+	if c.ListenAddress == "" {
+		return errors.New("--listen-address is required")
+	}
+
+	return nil
+}
+
+// Run serves the endpoints and lints the cluster once right away, then every Interval,
+// until ctx is done or the process receives SIGINT or SIGTERM.
+func (c *ServeCommand) Run(ctx context.Context) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var lc net.ListenConfig
+
+	listener, err := lc.Listen(ctx, "tcp", c.ListenAddress)
+	if err != nil {
+		return fmt.Errorf("listening on %s: %w", c.ListenAddress, err)
+	}
+
+	server := &http.Server{
+		Handler:           c.monitor.Handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	serveErr := make(chan error, 1)
+
+	go func() {
+		serveErr <- server.Serve(listener)
+	}()
+
+	// Messages of the long-running mode are shown in quiet mode too.
+	_, _ = fmt.Fprintf(c.IO.ErrOut(), "Serving %s and %s on %s, linting every %s\n",
+		MetricsPath, ReportPath, listener.Addr(), c.Interval)
+
+	ticker := time.NewTicker(c.Interval)
+	defer ticker.Stop()
+
+	c.lintOnce(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+			defer cancel()
+
+			if err := server.Shutdown(shutdownCtx); err != nil {
+				return fmt.Errorf("shutting down: %w", err)
+			}
+
+			return nil
+		case err := <-serveErr:
+			return fmt.Errorf("serving on %s: %w", c.ListenAddress, err)
+		case <-ticker.C:
+			c.lintOnce(ctx)
+		}
+	}
+}
+
+// lintOnce assesses the cluster and records the outcome in the monitor. A failed run is
+// counted and reported, and the outcome of the previous run is kept.
+func (c *ServeCommand) lintOnce(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	// Each run reads the cluster afresh through its own cache.
+	run := c.forCluster(c.ConfigFlags, c.Client)
+	run.Reader = client.NewCachedReader(c.Reader)
+
+	results, err := run.assess(ctx)
+	if err != nil {
+		c.monitor.RecordFailure()
+		_, _ = fmt.Fprintf(c.IO.ErrOut(), "Warning: lint run failed: %v\n", err)
+
+		return
+	}
+
+	list := newDiagnosticResultList(results, &run.currentClusterVersion, run.targetVersionPtr(), run.openShiftVersionPtr())
+	list.WorkloadScope = run.namespaces.Report()
+
+	if err := c.monitor.Record(list, results, time.Now()); err != nil {
+		c.monitor.RecordFailure()
+		_, _ = fmt.Fprintf(c.IO.ErrOut(), "Warning: recording lint run: %v\n", err)
+
+		return
+	}
+
+	findings := SummarizeFindings(results)
+	c.IO.Errorf("Lint run completed: %s (%d checks)", verdictName(findings), len(results))
+
+	if c.Publish {
+		if err := run.publishReport(ctx, results); err != nil {
+			_, _ = fmt.Fprintf(c.IO.ErrOut(), "Warning: %v\n", err)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package lint_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestServeCommand_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	command := lint.NewServeCommand(genericiooptions.NewTestIOStreamsDiscard(), testConfigFlags())
+	g.Expect(command.Validate()).To(Succeed())
+
+	command.Interval = 0
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--interval must be positive")))
+
+	command.Interval = time.Minute
+	command.ListenAddress = ""
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--listen-address is required")))
+}
+
+func TestServeCommand_Run(t *testing.T) {
+	g := NewWithT(t)
+
+	snapshotDir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(snapshotDir, "dsc.yaml"), []byte(snapshotDSC), 0o600)).To(Succeed())
+
+	// Reserve a free port for the endpoints.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	address := listener.Addr().String()
+	g.Expect(listener.Close()).To(Succeed())
+
+	command := lint.NewServeCommand(genericiooptions.NewTestIOStreamsDiscard(), testConfigFlags())
+	command.FromSnapshot = snapshotDir
+	command.TargetVersion = "3.0.0"
+	command.ListenAddress = address
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- command.Run(ctx)
+	}()
+
+	get := func(path string) (string, error) {
+		resp, err := http.Get("http://" + address + path) //nolint:noctx // Test helper
+		if err != nil {
+			return "", err
+		}
+
+		defer func() { _ = resp.Body.Close() }()
+
+		if resp.StatusCode != http.StatusOK {
+			return "", errors.New(resp.Status)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+
+		return string(body), err
+	}
+
+	// The first run starts right away.
+	g.Eventually(func() (string, error) { return get(lint.ReportPath) }).
+		WithTimeout(30 * time.Second).
+		Should(ContainSubstring(`"clusterVersion":"2.25.0"`))
+
+	g.Expect(get(lint.MetricsPath)).To(ContainSubstring(`odh_lint_version_info{openshift_version="",rhoai_version="2.25.0",target_version="3.0.0"} 1`))
+
+	cancel()
+	g.Eventually(done).WithTimeout(10 * time.Second).Should(Receive(BeNil()))
+}
@@ -0,0 +1,175 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/blang/semver/v4"
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/snapshot"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/iostreams"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+)
+
+// Verify SnapshotCommand implements cmd.Command interface at compile time.
+var _ cmd.Command = (*SnapshotCommand)(nil)
+
+// SnapshotCommand runs the lint checks against the cluster and captures every object
+// they read, producing a snapshot that `lint --from-snapshot` can replay offline.
+type SnapshotCommand struct {
+	*SharedOptions
+
+	// TargetVersion is the upgrade target the checks are evaluated against.
+	TargetVersion string
+
+	// OutputDir is the snapshot destination: a directory, or a tar archive when it
+	// ends in .tar, .tar.gz or .tgz.
+	OutputDir string
+
+	// parsedTargetVersion is the parsed semver version
+	parsedTargetVersion *semver.Version
+
+	// registry is the check registry for this command instance.
+	registry *check.CheckRegistry
+}
+
+// NewSnapshotCommand creates a new SnapshotCommand with defaults.
+func NewSnapshotCommand(
+	streams genericiooptions.IOStreams,
+	configFlags *genericclioptions.ConfigFlags,
+) *SnapshotCommand {
+	return &SnapshotCommand{
+		SharedOptions: NewSharedOptions(streams, configFlags),
+		registry:      newCheckRegistry(),
+	}
+}
+
+// AddFlags registers command-specific flags with the provided FlagSet.
+func (c *SnapshotCommand) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.TargetVersion, "target-version", "", flagDescTargetVersion)
+	fs.StringVar(&c.OutputDir, "output-dir", "", flagDescSnapshotOutputDir)
+	fs.StringArrayVar(&c.CheckSelectors, "checks", []string{"*"}, flagDescChecks)
+	fs.BoolVarP(&c.Verbose, "verbose", "v", false, flagDescVerbose)
+	fs.BoolVar(&c.Debug, "debug", false, flagDescDebug)
+	fs.DurationVar(&c.Timeout, "timeout", c.Timeout, flagDescTimeout)
+	fs.IntVar(&c.Parallelism, "parallelism", c.Parallelism, flagDescParallelism)
+	fs.StringArrayVar(&c.CheckTimeouts, "check-timeout", nil, flagDescCheckTimeout)
+	fs.StringVar(&c.FromSnapshot, "from-snapshot", "", flagDescFromSnapshot)
+	fs.StringVar(&c.CheckDir, "check-dir", "", flagDescCheckDir)
+
+	// Throttling settings
+	fs.Float32Var(&c.QPS, "qps", c.QPS, flagDescQPS)
+	fs.IntVar(&c.Burst, "burst", c.Burst, flagDescBurst)
+}
+
+// Complete populates Options and performs pre-validation setup.
+func (c *SnapshotCommand) Complete() error {
+	if err := c.SharedOptions.Complete(); err != nil {
+		return fmt.Errorf("completing shared options: %w", err)
+	}
+
+	if !c.Verbose && !c.Debug {
+		c.IO = iostreams.NewQuietWrapper(c.IO)
+	}
+
+	if c.TargetVersion != "" {
+		// Use ParseTolerant to accept partial versions (e.g., "3.0" → "3.0.0")
+		targetVer, err := semver.ParseTolerant(c.TargetVersion)
+		if err != nil {
+			return fmt.Errorf("invalid target version %q: %w", c.TargetVersion, err)
+		}
+		c.parsedTargetVersion = &targetVer
+	}
+
+	if err := registerCheckDir(c.registry, c.CheckDir); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Validate checks that all required options are valid.
+func (c *SnapshotCommand) Validate() error {
+	if err := c.SharedOptions.Validate(); err != nil {
+		return fmt.Errorf("validating shared options: %w", err)
+	}
+
+	if c.TargetVersion == "" {
+		return errors.New("--target-version flag is required")
+	}
+
+	if c.OutputDir == "" {
+		return errors.New("--output-dir flag is required")
+	}
+
+	return nil
+}
+
+// Run executes the selected checks through a recording reader and writes the captured objects.
+func (c *SnapshotCommand) Run(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	// The recorder sits in front of the cache so it sees every read, including hits.
+	recorder := snapshot.NewRecorder(client.NewCachedReader(c.Reader))
+
+	currentVersion, err := version.Detect(ctx, recorder)
+	if err != nil {
+		return fmt.Errorf("detecting cluster version: %w", err)
+	}
+
+	if _, err := version.DetectOpenShiftVersion(ctx, recorder); err != nil {
+		c.IO.Errorf("Warning: Failed to detect OpenShift version: %v", err)
+	}
+
+	c.IO.Errorf("Capturing objects read by checks: %s → %s\n", currentVersion.String(), c.TargetVersion)
+
+	opts, err := c.executorOptions(c.registry)
+	if err != nil {
+		return err
+	}
+
+	executor := check.NewExecutor(c.registry, c.IO, opts...)
+	checkTarget := check.Target{
+		Client:         recorder,
+		CurrentVersion: currentVersion,
+		TargetVersion:  c.parsedTargetVersion,
+		IO:             c.IO,
+		Debug:          c.Debug,
+	}
+
+	var executions []check.CheckExecution
+
+	for _, group := range check.CanonicalGroupOrder {
+		results, err := executor.ExecuteSelective(ctx, checkTarget, c.CheckSelectors, group)
+		if err != nil {
+			return fmt.Errorf("executing %s checks: %w", group, err)
+		}
+
+		for _, exec := range results {
+			if exec.Result != nil {
+				executions = append(executions, exec)
+			}
+		}
+	}
+
+	// Namespaces of impacted objects are read for the verbose table output.
+	collectNamespaceRequesters(ctx, recorder, executions)
+
+	objects := recorder.Objects()
+	if err := snapshot.Write(c.OutputDir, objects); err != nil {
+		return fmt.Errorf("writing snapshot: %w", err)
+	}
+
+	c.IO.Fprintf("Captured %d objects from %d checks to %s", len(objects), len(executions), c.OutputDir)
+
+	return nil
+}
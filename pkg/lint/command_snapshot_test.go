@@ -0,0 +1,74 @@
+package lint_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSnapshotCommand_Validate(t *testing.T) {
+	t.Run("should require target version and output dir", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := lint.NewSnapshotCommand(genericiooptions.IOStreams{
+			In:     &bytes.Buffer{},
+			Out:    &bytes.Buffer{},
+			ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("--target-version")))
+
+		command.TargetVersion = "3.0.0"
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("--output-dir")))
+
+		command.OutputDir = t.TempDir()
+		g.Expect(command.Validate()).To(Succeed())
+	})
+}
+
+func TestSnapshotCommand_RoundTrip(t *testing.T) {
+	t.Run("captured archive should replay with --from-snapshot", func(t *testing.T) {
+		g := NewWithT(t)
+
+		source := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(source, "dsc.yaml"), []byte(snapshotDSC), 0o600)).To(Succeed())
+
+		archive := filepath.Join(t.TempDir(), "lint-snapshot.tar.gz")
+
+		var out bytes.Buffer
+		capture := lint.NewSnapshotCommand(genericiooptions.IOStreams{
+			In:     &bytes.Buffer{},
+			Out:    &out,
+			ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+		capture.FromSnapshot = source
+		capture.TargetVersion = "3.0.0"
+		capture.OutputDir = archive
+
+		g.Expect(capture.Complete()).To(Succeed())
+		g.Expect(capture.Validate()).To(Succeed())
+		g.Expect(capture.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring("Captured 1 objects"))
+		g.Expect(archive).To(BeAnExistingFile())
+
+		var replayOut bytes.Buffer
+		replay := lint.NewCommand(genericiooptions.IOStreams{
+			In:     &bytes.Buffer{},
+			Out:    &replayOut,
+			ErrOut: &bytes.Buffer{},
+		}, testConfigFlags(), lint.WithTargetVersion("3.0.0"))
+		replay.FromSnapshot = archive
+		replay.OutputFormat = lint.OutputFormatJSON
+
+		g.Expect(replay.Complete()).To(Succeed())
+		g.Expect(replay.Run(t.Context())).To(Succeed())
+		g.Expect(replayOut.String()).To(ContainSubstring(`"clusterVersion": "2.25.0"`))
+	})
+}
@@ -2,15 +2,21 @@ package lint_test
 
 import (
 	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/spf13/pflag"
 
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/opendatahub-io/odh-cli/pkg/cmd"
 	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
 
 	. "github.com/onsi/gomega"
 )
@@ -22,7 +28,7 @@ func testConfigFlags() *genericclioptions.ConfigFlags {
 
 // T022: Test lint mode (no --target-version flag).
 func TestLintMode_NoVersionFlag(t *testing.T) {
-	t.Run("lint mode should skip checks when no target version provided", func(t *testing.T) {
+	t.Run("lint mode should validate the current installation when no target version provided", func(t *testing.T) {
 		g := NewWithT(t)
 
 		var out, errOut bytes.Buffer
@@ -36,8 +42,8 @@ func TestLintMode_NoVersionFlag(t *testing.T) {
 
 		g.Expect(cmd.TargetVersion).To(BeEmpty())
 
-		// Without --target-version, Run() will short-circuit when
-		// current and target versions share the same major.minor
+		// Without --target-version, Run() validates the current installation
+		// by running every check applicable to the detected version
 		err := cmd.Complete()
 		g.Expect(err).ToNot(HaveOccurred())
 	})
@@ -86,8 +92,8 @@ func TestLintMode_CheckTargetVersionMatches(t *testing.T) {
 		// Verify no --target-version flag set (lint mode)
 		g.Expect(command.TargetVersion).To(BeEmpty())
 
-		// In lint mode, Run() detects that current == target (same major.minor)
-		// and short-circuits with a "no checks will be executed" message
+		// In lint mode, Run() executes checks with CurrentVersion and
+		// TargetVersion both set to the detected cluster version
 	})
 }
 
@@ -156,8 +162,8 @@ func TestIntegration_LintAndUpgradeModes(t *testing.T) {
 		g.Expect(err).ToNot(HaveOccurred())
 
 		// Note: Full end-to-end Run() testing requires k3s-envtest infrastructure
-		// Run() prints environment, then either short-circuits (same major.minor)
-		// or delegates to runUpgradeMode() (different major.minor)
+		// Run() delegates to runLintMode() (same major.minor) or
+		// runUpgradeMode() (different major.minor)
 	})
 }
 
@@ -188,6 +194,8 @@ func TestCommand_AddFlags(t *testing.T) {
 		g.Expect(fs.Lookup("output")).ToNot(BeNil())
 		g.Expect(fs.Lookup("checks")).ToNot(BeNil())
 		g.Expect(fs.Lookup("timeout")).ToNot(BeNil())
+		g.Expect(fs.Lookup("from-snapshot")).ToNot(BeNil())
+		g.Expect(fs.Lookup("fail-on")).ToNot(BeNil())
 	})
 }
 
@@ -255,3 +263,425 @@ func TestCommand_FunctionalOptions(t *testing.T) {
 		g.Expect(command.IO).ToNot(BeNil())
 	})
 }
+
+const snapshotDSC = `apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+metadata:
+  name: default-dsc
+status:
+  release:
+    version: 2.25.0
+`
+
+// snapshotManagedDSC is snapshotDSC with Workbenches and KServe managed.
+const snapshotManagedDSC = snapshotDSC + `spec:
+  components:
+    workbenches:
+      managementState: Managed
+    kserve:
+      managementState: Managed
+`
+
+func TestCommand_FromSnapshot(t *testing.T) {
+	t.Run("should run upgrade checks against a snapshot without a cluster", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(snapshotDSC), 0o600)).To(Succeed())
+
+		var out, errOut bytes.Buffer
+		streams := genericiooptions.IOStreams{
+			In:     &bytes.Buffer{},
+			Out:    &out,
+			ErrOut: &errOut,
+		}
+
+		command := lint.NewCommand(streams, testConfigFlags(), lint.WithTargetVersion("3.0.0"))
+		command.FromSnapshot = dir
+		command.OutputFormat = lint.OutputFormatJSON
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Client).To(BeNil())
+		g.Expect(command.Reader).ToNot(BeNil())
+
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring(`"clusterVersion": "2.25.0"`))
+	})
+
+	t.Run("should validate the current installation without a target version", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(snapshotManagedDSC), 0o600)).To(Succeed())
+
+		var out bytes.Buffer
+		command := lint.NewCommand(genericiooptions.IOStreams{
+			In:     &bytes.Buffer{},
+			Out:    &out,
+			ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+		command.FromSnapshot = dir
+		command.OutputFormat = lint.OutputFormatJSON
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring(`"clusterVersion": "2.25.0"`))
+		g.Expect(out.String()).ToNot(ContainSubstring(`"targetVersion"`))
+
+		// Version-agnostic health checks such as DSC readiness are executed.
+		g.Expect(out.String()).To(ContainSubstring(`"group": "platform"`))
+
+		// Upgrade migration checks are not.
+		g.Expect(out.String()).To(ContainSubstring(`"check.opendatahub.io/id"`))
+		g.Expect(out.String()).ToNot(ContainSubstring(`workloads.notebook.hardwareprofile-migration`))
+		g.Expect(out.String()).ToNot(ContainSubstring(`workloads.kserve.hardwareprofile-migration`))
+	})
+
+	t.Run("should write an HTML report file alongside the selected output", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(snapshotDSC), 0o600)).To(Succeed())
+
+		var out bytes.Buffer
+		command := lint.NewCommand(genericiooptions.IOStreams{
+			In:     &bytes.Buffer{},
+			Out:    &out,
+			ErrOut: &bytes.Buffer{},
+		}, testConfigFlags(), lint.WithTargetVersion("3.0.0"))
+		command.FromSnapshot = dir
+		command.OutputFormat = lint.OutputFormatJSON
+		command.ReportFile = filepath.Join(t.TempDir(), "report.html")
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring(`"clusterVersion": "2.25.0"`))
+
+		report, err := os.ReadFile(command.ReportFile)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(report)).To(HavePrefix("<!DOCTYPE html>"))
+		g.Expect(string(report)).To(ContainSubstring("2.25.0 &rarr; 3.0.0"))
+	})
+
+	t.Run("should warn about expired waivers", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(dir, "dsc.yaml"), []byte(snapshotDSC), 0o600)).To(Succeed())
+
+		waivers := filepath.Join(t.TempDir(), "waivers.yaml")
+		g.Expect(os.WriteFile(waivers, []byte(`waivers:
+  - check: "*"
+    reason: legacy exception
+    expires: "2020-01-31"
+`), 0o600)).To(Succeed())
+
+		var errOut bytes.Buffer
+		command := lint.NewCommand(genericiooptions.IOStreams{
+			In:     &bytes.Buffer{},
+			Out:    &bytes.Buffer{},
+			ErrOut: &errOut,
+		}, testConfigFlags(), lint.WithTargetVersion("3.0.0"))
+		command.FromSnapshot = dir
+		command.OutputFormat = lint.OutputFormatJSON
+		command.WaiversFile = waivers
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(errOut.String()).To(ContainSubstring(`waiver for "*" expired on 2020-01-31 and was not applied (legacy exception)`))
+	})
+
+	t.Run("should fail on an invalid waivers file", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := lint.NewCommand(genericiooptions.IOStreams{
+			In:     &bytes.Buffer{},
+			Out:    &bytes.Buffer{},
+			ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+		command.FromSnapshot = t.TempDir()
+		command.WaiversFile = filepath.Join(t.TempDir(), "missing.yaml")
+
+		g.Expect(command.Complete()).To(MatchError(ContainSubstring("loading waivers")))
+	})
+
+	t.Run("should fail when the snapshot directory does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := lint.NewCommand(genericiooptions.IOStreams{
+			In:     &bytes.Buffer{},
+			Out:    &bytes.Buffer{},
+			ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+		command.FromSnapshot = filepath.Join(t.TempDir(), "missing")
+
+		g.Expect(command.Complete()).To(MatchError(ContainSubstring("failed to load snapshot")))
+	})
+}
+
+const dscPolicyCheck = `id: platform.dsc.named-default
+name: "Platform :: DSC :: Named Default"
+description: Reports a DataScienceCluster still using the default name
+group: platform
+kind: dsc
+type: policy
+resource:
+  apiVersion: datasciencecluster.opendatahub.io/v1
+  kind: DataScienceCluster
+  plural: datascienceclusters
+predicate: '.metadata.name == "default-dsc"'
+impact: advisory
+message: "Found %d DataScienceCluster(s) named default-dsc"
+remediation: Follow the site naming policy
+`
+
+func TestCommand_CheckDir(t *testing.T) {
+	t.Run("should run declarative checks from the check directory", func(t *testing.T) {
+		g := NewWithT(t)
+
+		snapshotDir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(snapshotDir, "dsc.yaml"), []byte(snapshotDSC), 0o600)).To(Succeed())
+
+		checkDir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(checkDir, "dsc-name.yaml"), []byte(dscPolicyCheck), 0o600)).To(Succeed())
+
+		var out bytes.Buffer
+		command := lint.NewCommand(genericiooptions.IOStreams{
+			In:     &bytes.Buffer{},
+			Out:    &out,
+			ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+		command.FromSnapshot = snapshotDir
+		command.CheckDir = checkDir
+		command.CheckSelectors = []string{"platform.dsc.*"}
+		command.OutputFormat = lint.OutputFormatJSON
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring("Found 1 DataScienceCluster(s) named default-dsc"))
+	})
+
+	t.Run("should reject a definition reusing a built-in check ID", func(t *testing.T) {
+		g := NewWithT(t)
+
+		checkDir := t.TempDir()
+		definition := strings.Replace(dscPolicyCheck, "platform.dsc.named-default", "workloads.kserve.not-ready", 1)
+		g.Expect(os.WriteFile(filepath.Join(checkDir, "dup.yaml"), []byte(definition), 0o600)).To(Succeed())
+
+		command := lint.NewCommand(genericiooptions.NewTestIOStreamsDiscard(), testConfigFlags())
+		command.FromSnapshot = t.TempDir()
+		command.CheckDir = checkDir
+
+		g.Expect(command.Complete()).To(MatchError(ContainSubstring("already registered")))
+	})
+}
+
+func TestCommand_Plugins(t *testing.T) {
+	t.Run("should register check plugins found on PATH", func(t *testing.T) {
+		g := NewWithT(t)
+
+		pluginDir := t.TempDir()
+		pluginPath := filepath.Join(pluginDir, "odh-lint-check-workloads.kserve.not-ready")
+		g.Expect(os.WriteFile(pluginPath, []byte("#!/bin/sh\nexit 0\n"), 0o700)).To(Succeed())
+		t.Setenv("PATH", pluginDir)
+
+		command := lint.NewCommand(genericiooptions.NewTestIOStreamsDiscard(), testConfigFlags())
+
+		// The plugin reuses the ID of an embedded declarative check
+		g.Expect(command.Complete()).To(MatchError(And(
+			ContainSubstring("registering check plugin "+pluginPath),
+			ContainSubstring("already registered"),
+		)))
+	})
+
+	t.Run("should not run plugins against a snapshot", func(t *testing.T) {
+		g := NewWithT(t)
+
+		pluginDir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(pluginDir, "odh-lint-check-workloads.kserve.not-ready"),
+			[]byte("#!/bin/sh\nexit 0\n"), 0o700)).To(Succeed())
+		t.Setenv("PATH", pluginDir)
+
+		command := lint.NewCommand(genericiooptions.NewTestIOStreamsDiscard(), testConfigFlags())
+		command.FromSnapshot = t.TempDir()
+
+		g.Expect(command.Complete()).To(Succeed())
+	})
+
+	t.Run("should pass the connection flags to plugins", func(t *testing.T) {
+		g := NewWithT(t)
+
+		t.Setenv("KUBECONFIG", filepath.Join(t.TempDir(), "missing"))
+
+		flags := testConfigFlags()
+		*flags.APIServer = "https://api.example.com:6443"
+		*flags.BearerToken = "t0ken"
+		*flags.Impersonate = "admin"
+		*flags.ImpersonateGroup = []string{"ops"}
+		*flags.Namespace = "team-a,team-b"
+
+		command := lint.NewCommand(genericiooptions.NewTestIOStreamsDiscard(), flags)
+
+		conn, remove, err := lint.PluginConnection(command)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		kubeconfig, err := clientcmd.LoadFromFile(conn.Kubeconfig)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		loader := clientcmd.NewNonInteractiveClientConfig(*kubeconfig, conn.Context, nil, nil)
+
+		restConfig, err := loader.ClientConfig()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(restConfig.Host).To(Equal("https://api.example.com:6443"))
+		g.Expect(restConfig.BearerToken).To(Equal("t0ken"))
+		g.Expect(restConfig.Impersonate.UserName).To(Equal("admin"))
+		g.Expect(restConfig.Impersonate.Groups).To(Equal([]string{"ops"}))
+
+		// The workload scope is not a namespace plugins could connect to
+		namespace, overridden, err := loader.Namespace()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(namespace).To(Equal("default"))
+		g.Expect(overridden).To(BeFalse())
+
+		remove()
+
+		_, err = os.Stat(conn.Kubeconfig)
+		g.Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+}
+
+const notebookPolicyCheck = `id: workloads.notebook.policy
+name: "Workloads :: Notebook :: Policy"
+description: Reports every Notebook
+group: workload
+kind: notebook
+type: policy
+resource:
+  apiVersion: kubeflow.org/v1
+  kind: Notebook
+  plural: notebooks
+predicate: 'true'
+impact: advisory
+remediation: Follow the site policy
+`
+
+const scopedNotebooks = `apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: nb-a
+  namespace: team-a
+---
+apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: nb-b
+  namespace: team-b
+`
+
+func TestCommand_NamespaceScope(t *testing.T) {
+	t.Run("should limit workload checks to the selected namespaces", func(t *testing.T) {
+		g := NewWithT(t)
+
+		snapshotDir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(snapshotDir, "dsc.yaml"), []byte(snapshotDSC), 0o600)).To(Succeed())
+		g.Expect(os.WriteFile(filepath.Join(snapshotDir, "notebooks.yaml"), []byte(scopedNotebooks), 0o600)).To(Succeed())
+
+		checkDir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(checkDir, "policy.yaml"), []byte(notebookPolicyCheck), 0o600)).To(Succeed())
+
+		configFlags := testConfigFlags()
+		namespaces := "team-a,team-b"
+		configFlags.Namespace = &namespaces
+
+		var out bytes.Buffer
+		command := lint.NewCommand(genericiooptions.IOStreams{
+			In:     &bytes.Buffer{},
+			Out:    &out,
+			ErrOut: &bytes.Buffer{},
+		}, configFlags)
+		command.FromSnapshot = snapshotDir
+		command.CheckDir = checkDir
+		command.ExcludeNamespaces = []string{"team-b"}
+		command.CheckSelectors = []string{"workloads.notebook.policy"}
+		command.OutputFormat = lint.OutputFormatJSON
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+
+		var list result.DiagnosticResultList
+		g.Expect(json.Unmarshal(out.Bytes(), &list)).To(Succeed())
+		g.Expect(list.WorkloadScope).To(Equal(&result.WorkloadScope{
+			Namespaces:         []string{"team-a", "team-b"},
+			ExcludedNamespaces: []string{"team-b"},
+		}))
+		g.Expect(list.Results).To(HaveLen(1))
+		g.Expect(list.Results[0].ImpactedObjects).To(HaveLen(1))
+		g.Expect(list.Results[0].ImpactedObjects[0].Namespace).To(Equal("team-a"))
+	})
+
+	t.Run("should state the scope in the table header", func(t *testing.T) {
+		g := NewWithT(t)
+
+		snapshotDir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(snapshotDir, "dsc.yaml"), []byte(snapshotDSC), 0o600)).To(Succeed())
+
+		var out bytes.Buffer
+		command := lint.NewCommand(genericiooptions.IOStreams{
+			In:     &bytes.Buffer{},
+			Out:    &out,
+			ErrOut: &bytes.Buffer{},
+		}, testConfigFlags())
+		command.FromSnapshot = snapshotDir
+		command.NamespaceSelector = "tenant=acme"
+		command.FailOn = lint.FailOnNone
+
+		g.Expect(command.Complete()).To(Succeed())
+		g.Expect(command.Validate()).To(Succeed())
+		g.Expect(command.Run(t.Context())).To(Succeed())
+		g.Expect(out.String()).To(ContainSubstring("Workload scope:       selector tenant=acme"))
+	})
+
+	t.Run("should reject an invalid namespace selector", func(t *testing.T) {
+		g := NewWithT(t)
+
+		command := lint.NewCommand(genericiooptions.NewTestIOStreamsDiscard(), testConfigFlags())
+		command.NamespaceSelector = "tenant in ("
+
+		g.Expect(command.Validate()).To(MatchError(ContainSubstring("invalid namespace scope")))
+	})
+}
+
+func TestCommand_CheckTimeouts(t *testing.T) {
+	g := NewWithT(t)
+
+	snapshotDir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(snapshotDir, "dsc.yaml"), []byte(snapshotDSC), 0o600)).To(Succeed())
+
+	command := lint.NewCommand(genericiooptions.NewTestIOStreamsDiscard(), testConfigFlags())
+	command.FromSnapshot = snapshotDir
+	command.CheckTimeouts = []string{"1m", "workloads.unknown.check=5m"}
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(Succeed())
+	g.Expect(command.Run(t.Context())).To(MatchError(ContainSubstring(`unknown check "workloads.unknown.check"`)))
+}
+
+func TestCommand_PublishRequiresCluster(t *testing.T) {
+	g := NewWithT(t)
+
+	snapshotDir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(snapshotDir, "dsc.yaml"), []byte(snapshotDSC), 0o600)).To(Succeed())
+
+	command := lint.NewCommand(genericiooptions.NewTestIOStreamsDiscard(), testConfigFlags())
+	command.FromSnapshot = snapshotDir
+	command.Publish = true
+
+	g.Expect(command.Complete()).To(Succeed())
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--publish cannot be used with --from-snapshot")))
+}
@@ -3,14 +3,36 @@ package lint
 // Flag descriptions for the lint command.
 const (
 	flagDescTargetVersion      = "target version for upgrade readiness checks (e.g., 2.25.0, 3.0.0)"
-	flagDescOutput             = "output format (table|json|yaml)"
+	flagDescOutput             = "output format (table|json|yaml|junit|sarif|html)"
 	flagDescSeverity           = "minimum severity level to display (prohibited|critical|warning|info)"
 	flagDescVerbose            = "show impacted objects and summary information"
 	flagDescDebug              = "show detailed diagnostic logs for troubleshooting"
 	flagDescTimeout            = "operation timeout (e.g., 10m, 30m)"
+	flagDescParallelism        = "maximum number of checks run concurrently within a check group"
+	flagDescCheckTimeout       = "deadline of a single check (default 2m), or CHECK_ID=DURATION to override it for one check (repeatable)"
 	flagDescQPS                = "Kubernetes API QPS limit (queries per second)"
 	flagDescBurst              = "Kubernetes API burst capacity"
 	flagDescISVCDeploymentMode = "filter InferenceService display by deployment mode (all|serverless|modelmesh)"
+	flagDescFromSnapshot       = "lint a captured cluster snapshot (must-gather, backup directory or tar archive) instead of a live cluster"
+	flagDescDiffOutput         = "output format (table|json)"
+	flagDescReportFile         = "also write a self-contained HTML report to this file"
+	flagDescFailOn             = "exit with a non-zero code on findings at or above this level (prohibited|blocking|advisory|error|none)"
+	flagDescPublish            = "write the results to the odh-upgrade-readiness ConfigMap in the applications namespace"
+	flagDescWaivers            = "YAML file of waivers accepting known findings until their expiry date"
+	flagDescSnapshotOutputDir  = "snapshot destination directory, or archive path ending in .tar, .tar.gz or .tgz"
+	flagDescFixApply           = "apply the patches to the cluster after confirmation instead of printing them"
+	flagDescContexts           = "comma-separated kubeconfig contexts to lint as a fleet, each with its own detected version"
+	flagDescAllContexts        = "lint every context in the kubeconfig as a fleet"
+	flagDescCheckDir           = "directory of declarative YAML check definitions to run in addition to the built-in checks"
+	flagDescPlanTargetVersion  = "version to plan the upgrade to (e.g., 3.3.0)"
+	flagDescPlanOutput         = "output format (table|json|yaml)"
+	flagDescListTargetVersion  = "version to evaluate check applicability against (e.g., 3.0); defaults to the installed version"
+	flagDescCatalogOutput      = "output format (table|json|yaml)"
+	flagDescNamespaceSelector  = "limit workload checks to namespaces matching this label selector (e.g., tenant=team-a)"
+	flagDescExcludeNamespace   = "leave this namespace out of workload checks (repeatable)"
+	flagDescListenAddress      = "address the metrics and report endpoints listen on"
+	flagDescInterval           = "time between two lint runs"
+	flagDescServePublish       = "also write the results of every run to the odh-upgrade-readiness ConfigMap"
 )
 
 const flagDescChecks = `check selector patterns (glob patterns or categories):
@@ -0,0 +1,210 @@
+package lint
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+// ChangeType classifies how a check's result differs between two lint reports.
+type ChangeType string
+
+const (
+	// ChangeNewlyFailing marks a check that passed (or was absent) before and now fails.
+	ChangeNewlyFailing ChangeType = "newly-failing"
+	// ChangeNewlyPassing marks a check that failed before and now passes.
+	ChangeNewlyPassing ChangeType = "newly-passing"
+	// ChangeImpactChanged marks a check that fails in both reports at different impact levels.
+	ChangeImpactChanged ChangeType = "impact-changed"
+	// ChangeObjectsChanged marks a check whose status and impact are unchanged but whose
+	// impacted objects differ.
+	ChangeObjectsChanged ChangeType = "objects-changed"
+	// ChangeAdded marks a passing check present only in the new report.
+	ChangeAdded ChangeType = "added"
+	// ChangeRemoved marks a check present only in the old report.
+	ChangeRemoved ChangeType = "removed"
+)
+
+// CheckDiff describes the change of a single check between two reports.
+type CheckDiff struct {
+	ID     string     `json:"id"     yaml:"id"`
+	Group  string     `json:"group"  yaml:"group"`
+	Kind   string     `json:"kind"   yaml:"kind"`
+	Name   string     `json:"name"   yaml:"name"`
+	Change ChangeType `json:"change" yaml:"change"`
+
+	// OldStatus and NewStatus are Pass, Fail or Error; empty when the check is absent.
+	OldStatus string `json:"oldStatus,omitempty" yaml:"oldStatus,omitempty"`
+	NewStatus string `json:"newStatus,omitempty" yaml:"newStatus,omitempty"`
+
+	// OldImpact and NewImpact are the highest impact across the check's conditions.
+	OldImpact result.Impact `json:"oldImpact,omitempty" yaml:"oldImpact,omitempty"`
+	NewImpact result.Impact `json:"newImpact,omitempty" yaml:"newImpact,omitempty"`
+
+	// AddedObjects are impacted objects reported only in the new report, as Kind/namespace/name.
+	AddedObjects []string `json:"addedObjects,omitempty" yaml:"addedObjects,omitempty"`
+
+	// ResolvedObjects are impacted objects reported only in the old report, as Kind/namespace/name.
+	ResolvedObjects []string `json:"resolvedObjects,omitempty" yaml:"resolvedObjects,omitempty"`
+}
+
+// ReportDiff is the difference between two lint reports.
+type ReportDiff struct {
+	OldClusterVersion *string `json:"oldClusterVersion,omitempty" yaml:"oldClusterVersion,omitempty"`
+	NewClusterVersion *string `json:"newClusterVersion,omitempty" yaml:"newClusterVersion,omitempty"`
+	OldTargetVersion  *string `json:"oldTargetVersion,omitempty"  yaml:"oldTargetVersion,omitempty"`
+	NewTargetVersion  *string `json:"newTargetVersion,omitempty"  yaml:"newTargetVersion,omitempty"`
+
+	// Changes lists every check that differs, in the order of the new report followed by
+	// checks removed since the old report. Unchanged checks are omitted.
+	Changes []CheckDiff `json:"changes" yaml:"changes"`
+}
+
+// LoadDiagnosticResultList reads a report written by `lint -o json` or `lint -o yaml`.
+func LoadDiagnosticResultList(path string) (*result.DiagnosticResultList, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading report: %w", err)
+	}
+
+	list := &result.DiagnosticResultList{}
+	if err := yaml.Unmarshal(data, list); err != nil {
+		return nil, fmt.Errorf("parsing report %s: %w", path, err)
+	}
+
+	return list, nil
+}
+
+// DiffResultLists compares two lint reports check by check. Checks are matched by
+// check ID; impacted objects are matched by Kind/namespace/name.
+func DiffResultLists(oldList *result.DiagnosticResultList, newList *result.DiagnosticResultList) *ReportDiff {
+	diff := &ReportDiff{
+		OldClusterVersion: oldList.ClusterVersion,
+		NewClusterVersion: newList.ClusterVersion,
+		OldTargetVersion:  oldList.TargetVersion,
+		NewTargetVersion:  newList.TargetVersion,
+		Changes:           make([]CheckDiff, 0),
+	}
+
+	oldByKey := make(map[string]*result.DiagnosticResult, len(oldList.Results))
+	for _, r := range oldList.Results {
+		if r != nil {
+			oldByKey[r.CheckID()] = r
+		}
+	}
+
+	seen := make(map[string]struct{}, len(newList.Results))
+
+	for _, r := range newList.Results {
+		if r == nil {
+			continue
+		}
+
+		key := r.CheckID()
+		seen[key] = struct{}{}
+
+		if d, changed := diffCheck(oldByKey[key], r); changed {
+			diff.Changes = append(diff.Changes, d)
+		}
+	}
+
+	for _, r := range oldList.Results {
+		if r == nil {
+			continue
+		}
+
+		if _, ok := seen[r.CheckID()]; ok {
+			continue
+		}
+
+		if d, changed := diffCheck(r, nil); changed {
+			diff.Changes = append(diff.Changes, d)
+		}
+	}
+
+	return diff
+}
+
+// diffCheck compares the results of one check; either side may be nil when the check
+// is missing from that report.
+func diffCheck(oldResult *result.DiagnosticResult, newResult *result.DiagnosticResult) (CheckDiff, bool) {
+	ref := newResult
+	if ref == nil {
+		ref = oldResult
+	}
+
+	d := CheckDiff{ID: ref.CheckID(), Group: ref.Group, Kind: ref.Kind, Name: ref.Name}
+
+	var oldObjects, newObjects []string
+
+	oldFailing, newFailing := false, false
+
+	if oldResult != nil {
+		d.OldStatus = oldResult.GetStatusString()
+		d.OldImpact = oldResult.GetImpact()
+		oldFailing = oldResult.IsFailing()
+		oldObjects = impactedObjectRefs(oldResult)
+	}
+
+	if newResult != nil {
+		d.NewStatus = newResult.GetStatusString()
+		d.NewImpact = newResult.GetImpact()
+		newFailing = newResult.IsFailing()
+		newObjects = impactedObjectRefs(newResult)
+	}
+
+	d.AddedObjects = subtract(newObjects, oldObjects)
+	d.ResolvedObjects = subtract(oldObjects, newObjects)
+
+	switch {
+	case newResult == nil:
+		d.Change = ChangeRemoved
+	case newFailing && !oldFailing:
+		d.Change = ChangeNewlyFailing
+	case oldResult == nil:
+		d.Change = ChangeAdded
+	case oldFailing && !newFailing:
+		d.Change = ChangeNewlyPassing
+	case newFailing && d.OldImpact != d.NewImpact:
+		d.Change = ChangeImpactChanged
+	case len(d.AddedObjects) > 0 || len(d.ResolvedObjects) > 0:
+		d.Change = ChangeObjectsChanged
+	default:
+		return d, false
+	}
+
+	return d, true
+}
+
+func impactedObjectRefs(r *result.DiagnosticResult) []string {
+	refs := make([]string, 0, len(r.ImpactedObjects))
+	for _, obj := range r.ImpactedObjects {
+		refs = append(refs, impactedObjectRef(obj))
+	}
+
+	return refs
+}
+
+// subtract returns the elements of a not in b, sorted and without duplicates.
+func subtract(a []string, b []string) []string {
+	exclude := make(map[string]struct{}, len(b))
+	for _, s := range b {
+		exclude[s] = struct{}{}
+	}
+
+	var out []string
+
+	for _, s := range a {
+		if _, ok := exclude[s]; !ok {
+			out = append(out, s)
+		}
+	}
+
+	slices.Sort(out)
+
+	return slices.Compact(out)
+}
@@ -0,0 +1,128 @@
+package lint_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+func diffResult(kind string, impact result.Impact, objects ...string) *result.DiagnosticResult {
+	cond := passCondition()
+	if impact != result.ImpactNone {
+		cond.Status = metav1.ConditionFalse
+		cond.Impact = impact
+	}
+
+	r := &result.DiagnosticResult{
+		Group:  "workload",
+		Kind:   kind,
+		Name:   "impacted-workloads",
+		Status: result.DiagnosticStatus{Conditions: []result.Condition{cond}},
+	}
+
+	for _, name := range objects {
+		r.ImpactedObjects = append(r.ImpactedObjects, metav1.PartialObjectMetadata{
+			TypeMeta:   metav1.TypeMeta{Kind: "Notebook"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: name},
+		})
+	}
+
+	return r
+}
+
+// withCheckID sets the ID of the check that produced r, as the executor does.
+func withCheckID(r *result.DiagnosticResult, id string) *result.DiagnosticResult {
+	r.Annotations = map[string]string{result.AnnotationCheckID: id}
+
+	return r
+}
+
+func diffList(results ...*result.DiagnosticResult) *result.DiagnosticResultList {
+	list := result.NewDiagnosticResultList(nil, nil, nil)
+	list.Results = results
+
+	return list
+}
+
+func TestDiffResultLists(t *testing.T) {
+	g := NewWithT(t)
+
+	oldList := diffList(
+		diffResult("unchanged", result.ImpactAdvisory, "a"),
+		diffResult("fixed", result.ImpactBlocking, "a"),
+		diffResult("broken", result.ImpactNone),
+		diffResult("escalated", result.ImpactAdvisory, "a"),
+		diffResult("moved", result.ImpactBlocking, "a", "b"),
+		diffResult("dropped", result.ImpactNone),
+	)
+	newList := diffList(
+		diffResult("unchanged", result.ImpactAdvisory, "a"),
+		diffResult("fixed", result.ImpactNone),
+		diffResult("broken", result.ImpactBlocking, "x"),
+		diffResult("escalated", result.ImpactProhibited, "a"),
+		diffResult("moved", result.ImpactBlocking, "b", "c"),
+		diffResult("introduced", result.ImpactNone),
+		diffResult("introduced-failing", result.ImpactAdvisory),
+	)
+
+	diff := lint.DiffResultLists(oldList, newList)
+
+	changes := make(map[string]lint.CheckDiff, len(diff.Changes))
+	kinds := make([]string, 0, len(diff.Changes))
+
+	for _, d := range diff.Changes {
+		changes[d.Kind] = d
+		kinds = append(kinds, d.Kind)
+	}
+
+	// Order follows the new report, with removed checks last; unchanged checks are omitted.
+	g.Expect(kinds).To(Equal([]string{"fixed", "broken", "escalated", "moved", "introduced", "introduced-failing", "dropped"}))
+
+	g.Expect(changes["fixed"].Change).To(Equal(lint.ChangeNewlyPassing))
+	g.Expect(changes["fixed"].ResolvedObjects).To(Equal([]string{"Notebook/ns1/a"}))
+
+	g.Expect(changes["broken"].Change).To(Equal(lint.ChangeNewlyFailing))
+	g.Expect(changes["broken"].AddedObjects).To(Equal([]string{"Notebook/ns1/x"}))
+
+	g.Expect(changes["escalated"].Change).To(Equal(lint.ChangeImpactChanged))
+	g.Expect(changes["escalated"].OldImpact).To(Equal(result.ImpactAdvisory))
+	g.Expect(changes["escalated"].NewImpact).To(Equal(result.ImpactProhibited))
+
+	g.Expect(changes["moved"].Change).To(Equal(lint.ChangeObjectsChanged))
+	g.Expect(changes["moved"].AddedObjects).To(Equal([]string{"Notebook/ns1/c"}))
+	g.Expect(changes["moved"].ResolvedObjects).To(Equal([]string{"Notebook/ns1/a"}))
+
+	g.Expect(changes["introduced"].Change).To(Equal(lint.ChangeAdded))
+	g.Expect(changes["introduced-failing"].Change).To(Equal(lint.ChangeNewlyFailing))
+	g.Expect(changes["dropped"].Change).To(Equal(lint.ChangeRemoved))
+	g.Expect(changes["dropped"].NewStatus).To(BeEmpty())
+}
+
+func TestDiffResultLists_SharedGroupKindName(t *testing.T) {
+	g := NewWithT(t)
+
+	// Both checks report workload.kserve.impacted-workloads
+	path := writeReport(t, "report.json",
+		withCheckID(diffResult("kserve", result.ImpactBlocking, "a"), "workloads.kserve.raw-deployment"),
+		withCheckID(diffResult("kserve", result.ImpactNone), "workloads.kserve.serverless"),
+	)
+
+	list, err := lint.LoadDiagnosticResultList(path)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(lint.DiffResultLists(list, list).Changes).To(BeEmpty())
+
+	fixed := diffList(
+		withCheckID(diffResult("kserve", result.ImpactNone), "workloads.kserve.raw-deployment"),
+		withCheckID(diffResult("kserve", result.ImpactNone), "workloads.kserve.serverless"),
+	)
+
+	g.Expect(lint.DiffResultLists(list, fixed).Changes).To(HaveExactElements(
+		And(HaveField("ID", "workloads.kserve.raw-deployment"), HaveField("Change", lint.ChangeNewlyPassing)),
+	))
+}
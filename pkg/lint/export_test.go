@@ -0,0 +1,19 @@
+package lint
+
+import (
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/plugin"
+)
+
+// MemberNamespaceScope returns the namespace scope of the command that lints the
+// given context in fleet mode.
+func MemberNamespaceScope(c *Command, contextName string) (*check.NamespaceScope, error) {
+	member := c.forCluster(contextConfigFlags(c.ConfigFlags, contextName), nil)
+
+	return member.NamespaceScope()
+}
+
+// PluginConnection writes the connection plugins receive from c.
+func PluginConnection(c *Command) (plugin.Connection, func(), error) {
+	return writePluginConnection(c.ConfigFlags)
+}
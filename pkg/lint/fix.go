@@ -0,0 +1,118 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+// Fix is a remediation patch produced by a check.
+type Fix struct {
+	// CheckID is the ID of the check that produced the patch.
+	CheckID string
+
+	check.Patch
+}
+
+// CollectFixes asks every check implementing check.Fixer for patches that remediate its
+// failing results. Results without failing conditions are skipped.
+func CollectFixes(ctx context.Context, target check.Target, results []check.CheckExecution) ([]Fix, error) {
+	var fixes []Fix
+
+	for _, exec := range results {
+		if exec.Result == nil || !exec.Result.IsFailing() {
+			continue
+		}
+
+		fixer, ok := exec.Check.(check.Fixer)
+		if !ok {
+			continue
+		}
+
+		patches, err := fixer.Fix(ctx, target, exec.Result)
+		if err != nil {
+			return nil, fmt.Errorf("computing fixes for %s: %w", exec.Check.ID(), err)
+		}
+
+		for _, p := range patches {
+			fixes = append(fixes, Fix{CheckID: exec.Check.ID(), Patch: p})
+		}
+	}
+
+	return fixes, nil
+}
+
+// WriteFixBundle writes the fixes as a shell script of `kubectl patch` commands that can
+// be reviewed and then run, or applied one by one.
+func WriteFixBundle(out io.Writer, fixes []Fix) error {
+	var b strings.Builder
+
+	_, _ = b.WriteString("#!/bin/sh\n")
+	_, _ = b.WriteString("# Remediation patches generated by 'kubectl odh lint fix'.\n")
+	_, _ = b.WriteString("# Review each patch before running this script.\n")
+	_, _ = b.WriteString("set -e\n")
+
+	for _, f := range fixes {
+		_, _ = fmt.Fprintf(&b, "\n# %s: %s\n", f.CheckID, f.Description)
+		_, _ = fmt.Fprintf(&b, "kubectl patch %s %s", kubectlResource(f.Patch), f.Name)
+
+		if f.Namespace != "" {
+			_, _ = fmt.Fprintf(&b, " -n %s", f.Namespace)
+		}
+
+		_, _ = fmt.Fprintf(&b, " --type %s -p %s\n", f.Type, shellQuote(string(f.Data)))
+	}
+
+	if _, err := io.WriteString(out, b.String()); err != nil {
+		return fmt.Errorf("writing fix bundle: %w", err)
+	}
+
+	return nil
+}
+
+// ApplyFix patches the object on the cluster.
+func ApplyFix(ctx context.Context, c client.Client, f Fix) error {
+	patchType, err := f.Type.APIPatchType()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Dynamic().Resource(f.Resource.GVR()).
+		Namespace(f.Namespace).
+		Patch(ctx, f.Name, patchType, f.Data, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("patching %s: %w", fixObjectRef(f), err)
+	}
+
+	return nil
+}
+
+// fixObjectRef formats the patched object as Kind/namespace/name, or Kind/name when cluster-scoped.
+func fixObjectRef(f Fix) string {
+	if f.Namespace == "" {
+		return f.Resource.Kind + "/" + f.Name
+	}
+
+	return f.Resource.Kind + "/" + f.Namespace + "/" + f.Name
+}
+
+// kubectlResource returns the fully-qualified resource argument (resource.version.group)
+// so kubectl patches the same API version the check read.
+func kubectlResource(p check.Patch) string {
+	if p.Resource.Group == "" {
+		return p.Resource.Resource
+	}
+
+	return p.Resource.Resource + "." + p.Resource.Version + "." + p.Resource.Group
+}
+
+// shellQuote wraps s in single quotes for POSIX shells.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
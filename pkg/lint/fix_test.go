@@ -0,0 +1,141 @@
+package lint_test
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/checks/workloads/datasciencepipelines"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func newInstructLabDSPA(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.DataSciencePipelinesApplicationV1.APIVersion(),
+			"kind":       resources.DataSciencePipelinesApplicationV1.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": "ns1",
+			},
+			"spec": map[string]any{
+				"apiServer": map[string]any{
+					"managedPipelines": map[string]any{
+						"instructLab": map[string]any{"state": "Managed"},
+					},
+				},
+			},
+		},
+	}
+}
+
+// instructLabFixes runs the InstructLab removal check against a DSPA named "dspa"
+// and returns the target with the collected fixes.
+func instructLabFixes(t *testing.T) (check.Target, []lint.Fix) {
+	t.Helper()
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: map[schema.GroupVersionResource]string{
+			resources.DataScienceCluster.GVR():                resources.DataScienceCluster.ListKind(),
+			resources.DataSciencePipelinesApplicationV1.GVR(): resources.DataSciencePipelinesApplicationV1.ListKind(),
+		},
+		Objects: []*unstructured.Unstructured{
+			testutil.NewDSC(map[string]string{"datasciencepipelines": "Managed"}),
+			newInstructLabDSPA("dspa"),
+		},
+		CurrentVersion: "2.25.0",
+		TargetVersion:  "3.0.0",
+	})
+
+	chk := datasciencepipelines.NewInstructLabRemovalCheck()
+
+	dr, err := chk.Validate(t.Context(), target)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fixes, err := lint.CollectFixes(t.Context(), target, []check.CheckExecution{
+		{Check: chk, Result: dr},
+		// Checks without Fixer or without results are skipped.
+		{Check: chk},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return target, fixes
+}
+
+func TestCollectFixes(t *testing.T) {
+	g := NewWithT(t)
+
+	_, fixes := instructLabFixes(t)
+
+	g.Expect(fixes).To(HaveLen(1))
+	g.Expect(fixes[0].CheckID).To(Equal("workloads.datasciencepipelines.instructlab-removal"))
+	g.Expect(fixes[0].Name).To(Equal("dspa"))
+}
+
+func TestWriteFixBundle(t *testing.T) {
+	g := NewWithT(t)
+
+	fix, err := check.NewMergePatch(resources.ConfigMap, "opendatahub", "inferenceservice-config",
+		map[string]any{"data": map[string]string{"key": "it's"}}, "Update key")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, fixes := instructLabFixes(t)
+	fixes = append(fixes, lint.Fix{CheckID: "workloads.kserve.inferenceservice-config", Patch: fix})
+
+	var buf bytes.Buffer
+	g.Expect(lint.WriteFixBundle(&buf, fixes)).To(Succeed())
+
+	output := buf.String()
+	g.Expect(output).To(HavePrefix("#!/bin/sh\n"))
+	g.Expect(output).To(ContainSubstring("# workloads.datasciencepipelines.instructlab-removal: Remove the deprecated managedPipelines.instructLab field\n" +
+		"kubectl patch datasciencepipelinesapplications.v1.datasciencepipelinesapplications.opendatahub.io dspa -n ns1 --type json " +
+		`-p '[{"op":"remove","path":"/spec/apiServer/managedPipelines/instructLab"}]'`))
+	g.Expect(output).To(ContainSubstring(`kubectl patch configmaps inferenceservice-config -n opendatahub --type merge -p '{"data":{"key":"it'\''s"}}'`))
+}
+
+func TestApplyFix(t *testing.T) {
+	g := NewWithT(t)
+
+	target, fixes := instructLabFixes(t)
+	g.Expect(fixes).To(HaveLen(1))
+
+	c, ok := target.Client.(client.Client)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(lint.ApplyFix(t.Context(), c, fixes[0])).To(Succeed())
+
+	dspa, err := target.Client.GetResource(t.Context(), resources.DataSciencePipelinesApplicationV1, "dspa", client.InNamespace("ns1"))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, found, err := unstructured.NestedMap(dspa.Object, "spec", "apiServer", "managedPipelines", "instructLab")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(found).To(BeFalse())
+}
+
+func TestFixCommand_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	command := lint.NewFixCommand(genericiooptions.IOStreams{
+		In:     &bytes.Buffer{},
+		Out:    &bytes.Buffer{},
+		ErrOut: &bytes.Buffer{},
+	}, testConfigFlags())
+
+	g.Expect(command.Validate()).To(Succeed())
+
+	command.Apply = true
+	command.FromSnapshot = t.TempDir()
+	g.Expect(command.Validate()).To(MatchError(ContainSubstring("--apply cannot be used with --from-snapshot")))
+}
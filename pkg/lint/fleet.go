@@ -0,0 +1,437 @@
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/fatih/color"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	printerjson "github.com/opendatahub-io/odh-cli/pkg/printer/json"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	printeryaml "github.com/opendatahub-io/odh-cli/pkg/printer/yaml"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+//nolint:gochecknoglobals
+var fleetTableHeaders = []string{
+	"CONTEXT", "VERSION", "TARGET", "PROHIBITED", "BLOCKING", "ADVISORY", "ERRORS", "VERDICT",
+}
+
+// ClusterReport is the outcome of linting one cluster of a fleet.
+type ClusterReport struct {
+	// Context is the kubeconfig context the cluster was reached through.
+	Context string
+
+	// ClusterVersion is the detected OpenShift AI version.
+	ClusterVersion string
+
+	// TargetVersion is the upgrade target, empty when the installation was validated.
+	TargetVersion string
+
+	// OpenShiftVersion is the detected OpenShift platform version, empty if unknown.
+	OpenShiftVersion string
+
+	// WorkloadScope is the namespace scope of workload checks, nil when cluster-wide.
+	WorkloadScope *result.WorkloadScope
+
+	// Results are the check results, with waivers and the severity filter applied.
+	Results []check.CheckExecution
+
+	// Err is set when the cluster could not be linted, e.g. because it is unreachable.
+	Err error
+}
+
+// FleetReport is the JSON/YAML output of a fleet run: one DiagnosticResultList per
+// cluster keyed by kubeconfig context, and the errors of clusters that could not be linted.
+type FleetReport struct {
+	Clusters map[string]*result.DiagnosticResultList `json:"clusters"         yaml:"clusters"`
+	Errors   map[string]string                       `json:"errors,omitempty" yaml:"errors,omitempty"`
+}
+
+// ResolveContexts returns the kubeconfig contexts to lint: every context sorted by name
+// when all is set, otherwise the requested contexts, which must exist in the kubeconfig.
+func ResolveContexts(configFlags *genericclioptions.ConfigFlags, requested []string, all bool) ([]string, error) {
+	rawConfig, err := configFlags.ToRawKubeConfigLoader().RawConfig()
+	if err != nil {
+		return nil, fmt.Errorf("loading kubeconfig: %w", err)
+	}
+
+	if all {
+		if len(rawConfig.Contexts) == 0 {
+			return nil, errors.New("no contexts found in kubeconfig")
+		}
+
+		return slices.Sorted(maps.Keys(rawConfig.Contexts)), nil
+	}
+
+	contexts := make([]string, 0, len(requested))
+
+	for _, name := range requested {
+		if _, ok := rawConfig.Contexts[name]; !ok {
+			return nil, fmt.Errorf("context %q not found in kubeconfig", name)
+		}
+
+		if !slices.Contains(contexts, name) {
+			contexts = append(contexts, name)
+		}
+	}
+
+	return contexts, nil
+}
+
+// contextConfigFlags returns ConfigFlags that select the given context, keeping the
+// kubeconfig path, namespace scope, impersonation and request settings of base. Cluster and user
+// overrides are not carried over since they would point every context at one cluster.
+func contextConfigFlags(base *genericclioptions.ConfigFlags, contextName string) *genericclioptions.ConfigFlags {
+	flags := genericclioptions.NewConfigFlags(true)
+
+	flags.KubeConfig = base.KubeConfig
+	flags.Context = &contextName
+	flags.Namespace = base.Namespace
+	flags.Impersonate = base.Impersonate
+	flags.ImpersonateUID = base.ImpersonateUID
+	flags.ImpersonateGroup = base.ImpersonateGroup
+	flags.ImpersonateUserExtra = base.ImpersonateUserExtra
+	flags.Timeout = base.Timeout
+	flags.CacheDir = base.CacheDir
+
+	return flags
+}
+
+// fleetMode reports whether several clusters are linted through kubeconfig contexts.
+func (c *Command) fleetMode() bool {
+	return len(c.Contexts) > 0 || c.AllContexts
+}
+
+// validateFleet checks that the options are compatible with fleet mode.
+func (c *Command) validateFleet() error {
+	if !c.fleetMode() {
+		return nil
+	}
+
+	if len(c.Contexts) > 0 && c.AllContexts {
+		return errors.New("--contexts and --all-contexts are mutually exclusive")
+	}
+
+	if c.FromSnapshot != "" {
+		return errors.New("--contexts and --all-contexts cannot be used with --from-snapshot")
+	}
+
+	if c.ReportFile != "" {
+		return errors.New("--report-file is not supported with --contexts or --all-contexts")
+	}
+
+	switch c.OutputFormat {
+	case OutputFormatTable, OutputFormatJSON, OutputFormatYAML:
+		return nil
+	case OutputFormatJUnit, OutputFormatSARIF, OutputFormatHTML:
+		return fmt.Errorf("unsupported output format for fleet lint: %s (must be one of: table, json, yaml)", c.OutputFormat)
+	default:
+		return fmt.Errorf("invalid output format: %s (must be one of: table, json, yaml)", c.OutputFormat)
+	}
+}
+
+// runFleet lints every resolved context in turn, outputs the combined report and returns
+// a *VerdictError for the worst findings across the fleet. A cluster that cannot be
+// linted counts as an execution error and does not stop the others.
+func (c *Command) runFleet(ctx context.Context) error {
+	reports := make([]ClusterReport, 0, len(c.fleetContexts))
+
+	for _, name := range c.fleetContexts {
+		c.IO.Errorf("Linting context %s", name)
+
+		report := c.lintContext(ctx, name)
+		if report.Err != nil {
+			c.IO.Errorf("Warning: Failed to lint context %s: %v", name, report.Err)
+		}
+
+		reports = append(reports, report)
+	}
+
+	if err := c.outputFleet(reports); err != nil {
+		return err
+	}
+
+	findings := SummarizeFleet(reports)
+
+	if c.OutputFormat == OutputFormatTable {
+		printVerdict(c.IO.Out(), findings)
+	}
+
+	return findings.Evaluate(c.FailOn)
+}
+
+// lintContext assesses the cluster of one context. The timeout applies to each cluster.
+func (c *Command) lintContext(ctx context.Context, contextName string) ClusterReport {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	report := ClusterReport{Context: contextName}
+
+	configFlags := contextConfigFlags(c.ConfigFlags, contextName)
+
+	restConfig, err := client.NewRESTConfig(configFlags, c.QPS, c.Burst)
+	if err != nil {
+		report.Err = fmt.Errorf("failed to create REST config: %w", err)
+
+		return report
+	}
+
+	cl, err := client.NewClientWithConfig(restConfig)
+	if err != nil {
+		report.Err = fmt.Errorf("failed to create Kubernetes client: %w", err)
+
+		return report
+	}
+
+	member := c.forCluster(configFlags, cl)
+
+	results, err := member.assess(ctx)
+	if err != nil {
+		report.Err = err
+
+		return report
+	}
+
+	report.ClusterVersion = member.currentClusterVersion
+	report.TargetVersion = member.reportTargetVersion()
+	report.OpenShiftVersion = member.currentOpenShiftVersion
+	report.WorkloadScope = member.namespaces.Report()
+	report.Results = results
+
+	// A cluster whose report cannot be published is still part of the fleet verdict.
+	if c.Publish {
+		if err := member.publishReport(ctx, results); err != nil {
+			c.IO.Errorf("Warning: Failed to publish report of context %s: %v", contextName, err)
+		}
+	}
+
+	return report
+}
+
+// forCluster returns a Command that assesses one cluster of the fleet with the options
+// of c. The registry is shared since clusters are linted one at a time.
+func (c *Command) forCluster(configFlags *genericclioptions.ConfigFlags, cl client.Client) *Command {
+	opts := *c.SharedOptions
+	opts.ConfigFlags = configFlags
+	opts.Client = cl
+	opts.Reader = client.NewCachedReader(cl)
+
+	return &Command{
+		SharedOptions:       &opts,
+		TargetVersion:       c.TargetVersion,
+		FailOn:              c.FailOn,
+		Publish:             c.Publish,
+		ISVCDeploymentMode:  c.ISVCDeploymentMode,
+		waivers:             c.waivers,
+		parsedTargetVersion: c.parsedTargetVersion,
+		registry:            c.registry,
+	}
+}
+
+// outputFleet writes the combined fleet report in the selected output format.
+func (c *Command) outputFleet(reports []ClusterReport) error {
+	switch c.OutputFormat {
+	case OutputFormatJSON:
+		renderer := printerjson.NewRenderer[*FleetReport](
+			printerjson.WithWriter[*FleetReport](c.IO.Out()),
+		)
+
+		if err := renderer.Render(NewFleetReport(reports)); err != nil {
+			return fmt.Errorf("rendering JSON output: %w", err)
+		}
+
+		return nil
+	case OutputFormatYAML:
+		renderer := printeryaml.NewRenderer[*FleetReport](
+			printeryaml.WithWriter[*FleetReport](c.IO.Out()),
+		)
+
+		if err := renderer.Render(NewFleetReport(reports)); err != nil {
+			return fmt.Errorf("rendering YAML output: %w", err)
+		}
+
+		return nil
+	default:
+		// The scope flags are validated during Validate, so the error is always nil here.
+		if scope, _ := c.NamespaceScope(); scope != nil {
+			c.IO.Fprintf("Workload scope: %s\n", scope.String())
+		}
+
+		return OutputFleetTable(c.IO.Out(), reports)
+	}
+}
+
+// NewFleetReport builds the structured fleet output from the cluster reports.
+func NewFleetReport(reports []ClusterReport) *FleetReport {
+	fleet := &FleetReport{
+		Clusters: make(map[string]*result.DiagnosticResultList, len(reports)),
+	}
+
+	for _, r := range reports {
+		if r.Err != nil {
+			if fleet.Errors == nil {
+				fleet.Errors = make(map[string]string)
+			}
+
+			fleet.Errors[r.Context] = r.Err.Error()
+
+			continue
+		}
+
+		list := newDiagnosticResultList(
+			r.Results,
+			&r.ClusterVersion,
+			stringPtrOrNil(r.TargetVersion),
+			stringPtrOrNil(r.OpenShiftVersion),
+		)
+		list.WorkloadScope = r.WorkloadScope
+
+		fleet.Clusters[r.Context] = list
+	}
+
+	return fleet
+}
+
+// SummarizeFleet combines the findings of every cluster for the fleet verdict. A cluster
+// that could not be linted counts as one execution error.
+func SummarizeFleet(reports []ClusterReport) Findings {
+	var fleet Findings
+
+	for _, r := range reports {
+		if r.Err != nil {
+			fleet.ExecutionErrors++
+
+			continue
+		}
+
+		f := SummarizeFindings(r.Results)
+		fleet.Prohibited = fleet.Prohibited || f.Prohibited
+		fleet.Blocking = fleet.Blocking || f.Blocking
+		fleet.Advisory = fleet.Advisory || f.Advisory
+		fleet.ExecutionErrors += f.ExecutionErrors
+		fleet.NotExecuted += f.NotExecuted
+	}
+
+	return fleet
+}
+
+// fleetTableRow is a single row of the fleet verdict matrix.
+type fleetTableRow struct {
+	Context    string
+	Version    string
+	Target     string
+	Prohibited string
+	Blocking   string
+	Advisory   string
+	Errors     string
+	Verdict    string
+}
+
+// OutputFleetTable prints the per-cluster verdict matrix. The columns count the checks
+// whose highest unwaived finding is in each category, followed by the errors of the
+// clusters that could not be linted.
+func OutputFleetTable(out io.Writer, reports []ClusterReport) error {
+	renderer := table.NewRenderer[fleetTableRow](
+		table.WithWriter[fleetTableRow](out),
+		table.WithHeaders[fleetTableRow](fleetTableHeaders...),
+		table.WithTableOptions[fleetTableRow](table.DefaultTableOptions...),
+	)
+
+	for _, r := range reports {
+		row := fleetTableRow{Context: r.Context, Version: "-", Target: "-"}
+
+		if r.Err != nil {
+			row.Prohibited, row.Blocking, row.Advisory, row.Errors = "-", "-", "-", "-"
+			row.Verdict = color.New(color.FgMagenta, color.Bold).Sprint("ERROR")
+		} else {
+			counts := countFindings(r.Results)
+			findings := SummarizeFindings(r.Results)
+
+			row.Version = r.ClusterVersion
+			if r.TargetVersion != "" {
+				row.Target = r.TargetVersion
+			}
+
+			row.Prohibited = strconv.Itoa(counts[result.ImpactProhibited])
+			row.Blocking = strconv.Itoa(counts[result.ImpactBlocking])
+			row.Advisory = strconv.Itoa(counts[result.ImpactAdvisory])
+			row.Errors = strconv.Itoa(findings.ExecutionErrors + findings.NotExecuted)
+			row.Verdict = verdictLabel(findings)
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("appending table row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering table: %w", err)
+	}
+
+	for _, r := range reports {
+		if r.Err != nil {
+			_, _ = fmt.Fprintf(out, "\n%s: %v\n", r.Context, r.Err)
+		}
+	}
+
+	return nil
+}
+
+// countFindings counts the checks by their highest unwaived finding impact. Conditions
+// reporting that a check could not be executed are not counted.
+func countFindings(results []check.CheckExecution) map[result.Impact]int {
+	counts := make(map[result.Impact]int)
+
+	for _, exec := range results {
+		highest := result.ImpactNone
+
+		for _, cond := range exec.Result.Status.Conditions {
+			if cond.IsWaived() || check.IsExecutionError(cond) {
+				continue
+			}
+
+			if impactSortPriority(cond.Impact) < impactSortPriority(highest) {
+				highest = cond.Impact
+			}
+		}
+
+		counts[highest]++
+	}
+
+	return counts
+}
+
+// verdictLabel returns the colored verdict of a cluster, matching printVerdict.
+func verdictLabel(findings Findings) string {
+	name := verdictName(findings)
+
+	switch name {
+	case verdictProhibited, verdictFail:
+		return color.New(color.FgRed, color.Bold).Sprint(name)
+	case verdictWarning:
+		return color.New(color.FgYellow, color.Bold).Sprint(name)
+	case verdictIncomplete:
+		return color.New(color.FgMagenta, color.Bold).Sprint(name)
+	default:
+		return color.New(color.FgGreen, color.Bold).Sprint(name)
+	}
+}
+
+// stringPtrOrNil returns a pointer to s, or nil if s is empty.
+func stringPtrOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
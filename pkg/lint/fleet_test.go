@@ -0,0 +1,201 @@
+package lint_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+const fleetKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: east
+  cluster:
+    server: https://east.example.com:6443
+- name: west
+  cluster:
+    server: https://west.example.com:6443
+users:
+- name: admin
+  user:
+    token: test
+contexts:
+- name: west
+  context:
+    cluster: west
+    user: admin
+- name: east
+  context:
+    cluster: east
+    user: admin
+current-context: east
+`
+
+func TestResolveContexts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	if err := os.WriteFile(path, []byte(fleetKubeconfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	flags := testConfigFlags()
+	flags.KubeConfig = &path
+
+	t.Run("all contexts are sorted by name", func(t *testing.T) {
+		g := NewWithT(t)
+
+		contexts, err := lint.ResolveContexts(flags, nil, true)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(contexts).To(Equal([]string{"east", "west"}))
+	})
+
+	t.Run("requested contexts keep their order without duplicates", func(t *testing.T) {
+		g := NewWithT(t)
+
+		contexts, err := lint.ResolveContexts(flags, []string{"west", "east", "west"}, false)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(contexts).To(Equal([]string{"west", "east"}))
+	})
+
+	t.Run("unknown context is rejected", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := lint.ResolveContexts(flags, []string{"north"}, false)
+		g.Expect(err).To(MatchError(ContainSubstring(`context "north" not found`)))
+	})
+}
+
+func fleetReports() []lint.ClusterReport {
+	return []lint.ClusterReport{
+		{
+			Context:        "east",
+			ClusterVersion: "2.25.0",
+			TargetVersion:  "3.3.0",
+			Results: []check.CheckExecution{
+				{Result: diffResult("notebook", result.ImpactBlocking)},
+				{Result: diffResult("ray", result.ImpactNone)},
+			},
+		},
+		{
+			Context:        "west",
+			ClusterVersion: "3.3.0",
+			Results:        []check.CheckExecution{{Result: diffResult("notebook", result.ImpactNone)}},
+		},
+		{
+			Context: "north",
+			Err:     errors.New("detecting cluster version: connection refused"),
+		},
+	}
+}
+
+func TestNewFleetReport(t *testing.T) {
+	g := NewWithT(t)
+
+	fleet := lint.NewFleetReport(fleetReports())
+
+	g.Expect(fleet.Clusters).To(HaveLen(2))
+	g.Expect(fleet.Clusters["east"].Results).To(HaveLen(2))
+	g.Expect(*fleet.Clusters["east"].TargetVersion).To(Equal("3.3.0"))
+	g.Expect(fleet.Clusters["west"].TargetVersion).To(BeNil())
+	g.Expect(fleet.Errors).To(HaveKeyWithValue("north", ContainSubstring("connection refused")))
+}
+
+func TestSummarizeFleet(t *testing.T) {
+	g := NewWithT(t)
+
+	// The blocking finding of one cluster and the unreachable cluster both count.
+	g.Expect(lint.SummarizeFleet(fleetReports())).To(Equal(lint.Findings{Blocking: true, ExecutionErrors: 1}))
+}
+
+func TestOutputFleetTable(t *testing.T) {
+	g := NewWithT(t)
+
+	var out bytes.Buffer
+	g.Expect(lint.OutputFleetTable(&out, fleetReports())).To(Succeed())
+
+	output := out.String()
+	g.Expect(output).To(ContainSubstring("CONTEXT"))
+	g.Expect(output).To(ContainSubstring("east"))
+	g.Expect(output).To(ContainSubstring("FAIL"))
+	g.Expect(output).To(ContainSubstring("PASS"))
+	g.Expect(output).To(ContainSubstring("ERROR"))
+	g.Expect(output).To(ContainSubstring("north: detecting cluster version: connection refused"))
+}
+
+func TestCommand_ValidateFleet(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(c *lint.Command)
+		wantErr string
+	}{
+		{"contexts and all-contexts", func(c *lint.Command) {
+			c.Contexts = []string{"east"}
+			c.AllContexts = true
+		}, "mutually exclusive"},
+		{"snapshot", func(c *lint.Command) {
+			c.AllContexts = true
+			c.FromSnapshot = "./must-gather"
+		}, "cannot be used with --from-snapshot"},
+		{"report file", func(c *lint.Command) {
+			c.AllContexts = true
+			c.ReportFile = "report.html"
+		}, "--report-file is not supported"},
+		{"junit output", func(c *lint.Command) {
+			c.Contexts = []string{"east"}
+			c.OutputFormat = lint.OutputFormatJUnit
+		}, "unsupported output format for fleet lint"},
+		{"yaml output", func(c *lint.Command) {
+			c.Contexts = []string{"east", "west"}
+			c.OutputFormat = lint.OutputFormatYAML
+		}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := lint.NewCommand(genericiooptions.NewTestIOStreamsDiscard(), testConfigFlags())
+			tt.setup(c)
+
+			err := c.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+
+				return
+			}
+
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestCommand_FleetMemberNamespaceScope(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	g.Expect(os.WriteFile(path, []byte(fleetKubeconfig), 0o600)).To(Succeed())
+
+	flags := testConfigFlags()
+	flags.KubeConfig = &path
+	namespaces := "team-a"
+	flags.Namespace = &namespaces
+
+	c := lint.NewCommand(genericiooptions.NewTestIOStreamsDiscard(), flags)
+	c.AllContexts = true
+	c.ExcludeNamespaces = []string{"team-b"}
+
+	scope, err := lint.MemberNamespaceScope(c, "west")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(scope).ToNot(BeNil())
+	g.Expect(scope.Namespaces).To(Equal([]string{"team-a"}))
+	g.Expect(scope.Excluded).To(Equal([]string{"team-b"}))
+}
@@ -0,0 +1,208 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+const (
+	// metricsNamespace prefixes the names of the metrics exposed by lint serve.
+	metricsNamespace = "odh_lint"
+
+	// Paths served by the Monitor handler.
+	MetricsPath = "/metrics"
+	ReportPath  = "/report"
+	HealthPath  = "/healthz"
+
+	runResultSuccess = "success"
+	runResultError   = "error"
+)
+
+// findingImpacts are the impact levels reported by the findings gauge of every check,
+// so that checks without findings report explicit zeros.
+//
+//nolint:gochecknoglobals
+var findingImpacts = []result.Impact{result.ImpactProhibited, result.ImpactBlocking, result.ImpactAdvisory}
+
+// Monitor exposes the results of the latest lint run as Prometheus metrics and as a
+// DiagnosticResultList, for lint serve. It is safe for concurrent use.
+type Monitor struct {
+	registry *prometheus.Registry
+
+	findings        *prometheus.GaugeVec
+	executionFailed *prometheus.GaugeVec
+	impactedObjects *prometheus.GaugeVec
+	duration        *prometheus.HistogramVec
+	versionInfo     *prometheus.GaugeVec
+	lastRun         prometheus.Gauge
+	runs            *prometheus.CounterVec
+
+	mu     sync.RWMutex
+	report []byte
+}
+
+// NewMonitor creates a Monitor with its metrics registered and no report yet.
+func NewMonitor() *Monitor {
+	m := &Monitor{
+		registry: prometheus.NewRegistry(),
+		findings: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "check_findings",
+			Help:      "Unwaived findings of a check by impact in the latest run.",
+		}, []string{"check", "impact"}),
+		executionFailed: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "check_execution_failed",
+			Help:      "Whether a check failed or was not executed in the latest run (1) or not (0).",
+		}, []string{"check"}),
+		impactedObjects: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "check_impacted_objects",
+			Help:      "Objects impacted by a check per namespace in the latest run; cluster-scoped objects have an empty namespace.",
+		}, []string{"check", "namespace"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "check_duration_seconds",
+			Help:      "Time taken by a check to run.",
+			Buckets:   prometheus.ExponentialBuckets(0.05, 2, 12), //nolint:mnd // 50ms to ~100s
+		}, []string{"check"}),
+		versionInfo: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "version_info",
+			Help:      "Versions detected by the latest run, always 1.",
+		}, []string{"rhoai_version", "openshift_version", "target_version"}),
+		lastRun: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "last_run_timestamp_seconds",
+			Help:      "Unix time of the latest completed run.",
+		}),
+		runs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "runs_total",
+			Help:      "Lint runs by result: success, or error when the cluster could not be assessed.",
+		}, []string{"result"}),
+	}
+
+	m.registry.MustRegister(
+		m.findings,
+		m.executionFailed,
+		m.impactedObjects,
+		m.duration,
+		m.versionInfo,
+		m.lastRun,
+		m.runs,
+	)
+
+	return m
+}
+
+// Record replaces the metrics and the report with the outcome of a completed run. list
+// is the DiagnosticResultList of results; checks absent from results are no longer
+// reported.
+func (m *Monitor) Record(list *result.DiagnosticResultList, results []check.CheckExecution, now time.Time) error {
+	report, err := json.Marshal(list)
+	if err != nil {
+		return fmt.Errorf("encoding report: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.findings.Reset()
+	m.executionFailed.Reset()
+	m.impactedObjects.Reset()
+	m.versionInfo.Reset()
+
+	for _, exec := range results {
+		r := exec.Result
+
+		// Several checks share the same group, kind and name
+		id := r.CheckID()
+		if exec.Check != nil {
+			id = exec.Check.ID()
+		}
+
+		counts := make(map[result.Impact]int, len(findingImpacts))
+		failed := 0
+
+		for _, cond := range r.Status.Conditions {
+			switch {
+			case check.IsExecutionError(cond):
+				failed = 1
+			case !cond.IsWaived():
+				counts[cond.Impact]++
+			}
+		}
+
+		for _, impact := range findingImpacts {
+			m.findings.WithLabelValues(id, string(impact)).Set(float64(counts[impact]))
+		}
+
+		m.executionFailed.WithLabelValues(id).Set(float64(failed))
+
+		for _, obj := range r.ImpactedObjects {
+			m.impactedObjects.WithLabelValues(id, obj.Namespace).Inc()
+		}
+
+		if exec.Duration > 0 {
+			m.duration.WithLabelValues(id).Observe(exec.Duration.Seconds())
+		}
+	}
+
+	m.versionInfo.WithLabelValues(
+		stringValue(list.ClusterVersion),
+		stringValue(list.OpenShiftVersion),
+		stringValue(list.TargetVersion),
+	).Set(1)
+
+	m.lastRun.Set(float64(now.Unix()))
+	m.runs.WithLabelValues(runResultSuccess).Inc()
+	m.report = report
+
+	return nil
+}
+
+// RecordFailure counts a run that could not assess the cluster. The metrics and the
+// report of the latest completed run are kept.
+func (m *Monitor) RecordFailure() {
+	m.runs.WithLabelValues(runResultError).Inc()
+}
+
+// Handler returns the HTTP handler serving the metrics on MetricsPath, the latest
+// report as JSON on ReportPath and a liveness probe on HealthPath.
+func (m *Monitor) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.Handle("GET "+MetricsPath, promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+	mux.HandleFunc("GET "+ReportPath, m.serveReport)
+	mux.HandleFunc("GET "+HealthPath, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok\n"))
+	})
+
+	return mux
+}
+
+// serveReport writes the report of the latest completed run, or 503 until a run completed.
+func (m *Monitor) serveReport(w http.ResponseWriter, _ *http.Request) {
+	m.mu.RLock()
+	report := m.report
+	m.mu.RUnlock()
+
+	if report == nil {
+		http.Error(w, "no lint run has completed yet", http.StatusServiceUnavailable)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(report)
+}
@@ -0,0 +1,122 @@
+package lint_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+// scrape returns the status code and body of a GET on path of handler.
+func scrape(t *testing.T, handler http.Handler, path string) (int, string) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequestWithContext(t.Context(), http.MethodGet, path, nil))
+
+	body, err := io.ReadAll(rec.Result().Body)
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+
+	return rec.Code, string(body)
+}
+
+func TestMonitor_Record(t *testing.T) {
+	g := NewWithT(t)
+
+	monitor := lint.NewMonitor()
+	handler := monitor.Handler()
+
+	code, _ := scrape(t, handler, lint.ReportPath)
+	g.Expect(code).To(Equal(http.StatusServiceUnavailable))
+
+	version := "2.25.0"
+	kueue := check.CheckExecution{
+		Result:   diffResult("kueue", result.ImpactBlocking, "a", "b"),
+		Duration: 300 * time.Millisecond,
+	}
+	results := []check.CheckExecution{kueue, executionErrorResult()}
+
+	list := diffList(kueue.Result, results[1].Result)
+	list.ClusterVersion = &version
+
+	g.Expect(monitor.Record(list, results, time.Unix(1700000000, 0))).To(Succeed())
+
+	code, metrics := scrape(t, handler, lint.MetricsPath)
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(metrics).To(And(
+		ContainSubstring(`odh_lint_check_findings{check="workload.kueue.impacted-workloads",impact="blocking"} 1`),
+		ContainSubstring(`odh_lint_check_findings{check="workload.kueue.impacted-workloads",impact="prohibited"} 0`),
+		ContainSubstring(`odh_lint_check_execution_failed{check="workload.kueue.impacted-workloads"} 0`),
+		ContainSubstring(`odh_lint_check_execution_failed{check="workload.ray.impacted-workloads"} 1`),
+		ContainSubstring(`odh_lint_check_impacted_objects{check="workload.kueue.impacted-workloads",namespace="ns1"} 2`),
+		ContainSubstring(`odh_lint_check_duration_seconds_count{check="workload.kueue.impacted-workloads"} 1`),
+		ContainSubstring(`odh_lint_version_info{openshift_version="",rhoai_version="2.25.0",target_version=""} 1`),
+		ContainSubstring(`odh_lint_last_run_timestamp_seconds 1.7e+09`),
+		ContainSubstring(`odh_lint_runs_total{result="success"} 1`),
+	))
+
+	code, report := scrape(t, handler, lint.ReportPath)
+	g.Expect(code).To(Equal(http.StatusOK))
+
+	var decoded result.DiagnosticResultList
+	g.Expect(json.Unmarshal([]byte(report), &decoded)).To(Succeed())
+	g.Expect(decoded.Results).To(HaveLen(2))
+	g.Expect(decoded.ClusterVersion).To(HaveValue(Equal("2.25.0")))
+}
+
+func TestMonitor_RecordSharedGroupKindName(t *testing.T) {
+	g := NewWithT(t)
+
+	monitor := lint.NewMonitor()
+
+	// Both checks report workload.notebook.impacted-workloads
+	results := []check.CheckExecution{
+		waiverExecution("workloads.notebook.blocking", "a"),
+		waiverExecution("workloads.notebook.passing"),
+	}
+	results[1].Result = diffResult("notebook", result.ImpactNone)
+
+	g.Expect(monitor.Record(diffList(results[0].Result, results[1].Result), results, time.Now())).To(Succeed())
+
+	_, metrics := scrape(t, monitor.Handler(), lint.MetricsPath)
+	g.Expect(metrics).To(And(
+		ContainSubstring(`odh_lint_check_findings{check="workloads.notebook.blocking",impact="blocking"} 1`),
+		ContainSubstring(`odh_lint_check_findings{check="workloads.notebook.passing",impact="blocking"} 0`),
+		ContainSubstring(`odh_lint_check_impacted_objects{check="workloads.notebook.blocking",namespace="ns1"} 1`),
+	))
+	g.Expect(metrics).ToNot(ContainSubstring(`check="workload.notebook.impacted-workloads"`))
+}
+
+func TestMonitor_RecordReplacesPreviousRun(t *testing.T) {
+	g := NewWithT(t)
+
+	monitor := lint.NewMonitor()
+	handler := monitor.Handler()
+
+	first := []check.CheckExecution{{Result: diffResult("kueue", result.ImpactBlocking, "a")}}
+	g.Expect(monitor.Record(diffList(first[0].Result), first, time.Now())).To(Succeed())
+
+	// The finding is fixed and the check no longer reports impacted objects.
+	second := []check.CheckExecution{{Result: diffResult("kueue", result.ImpactNone)}}
+	g.Expect(monitor.Record(diffList(second[0].Result), second, time.Now())).To(Succeed())
+
+	// A failed run keeps the outcome of the previous run.
+	monitor.RecordFailure()
+
+	_, metrics := scrape(t, handler, lint.MetricsPath)
+	g.Expect(metrics).To(ContainSubstring(`odh_lint_check_findings{check="workload.kueue.impacted-workloads",impact="blocking"} 0`))
+	g.Expect(metrics).ToNot(ContainSubstring("odh_lint_check_impacted_objects{"))
+	g.Expect(metrics).To(ContainSubstring(`odh_lint_runs_total{result="success"} 2`))
+	g.Expect(metrics).To(ContainSubstring(`odh_lint_runs_total{result="error"} 1`))
+
+	code, _ := scrape(t, handler, lint.ReportPath)
+	g.Expect(code).To(Equal(http.StatusOK))
+}
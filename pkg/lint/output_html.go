@@ -0,0 +1,382 @@
+package lint
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+// HTMLOutputOptions configures the behavior of OutputHTML.
+type HTMLOutputOptions struct {
+	// VersionInfo contains version data to display in the report header.
+	VersionInfo *VersionInfo
+
+	// NamespaceRequesters maps namespace names to their openshift.io/requester annotation value.
+	// Shown next to each namespace in the impacted objects lists.
+	NamespaceRequesters map[string]string
+}
+
+// Impact CSS classes used by the HTML report.
+const (
+	htmlClassProhibited = "prohibited"
+	htmlClassBlocking   = "blocking"
+	htmlClassAdvisory   = "advisory"
+	htmlClassIncomplete = "incomplete"
+	htmlClassPass       = "pass"
+)
+
+type htmlReport struct {
+	Versions *VersionInfo
+	Verdict  htmlVerdict
+	Summary  htmlSummary
+	Groups   []*htmlGroup
+}
+
+type htmlVerdict struct {
+	Class string
+	Label string
+	Text  string
+
+	// Details lists the checks that did not produce a result, as printed by the table output.
+	Details []string
+}
+
+type htmlSummary struct {
+	Total      int
+	Passed     int
+	Warnings   int
+	Failed     int
+	Prohibited int
+	Waived     int
+}
+
+type htmlGroup struct {
+	Name   string
+	Class  string
+	Checks []htmlCheck
+
+	impact result.Impact
+}
+
+type htmlCheck struct {
+	Kind        string
+	Name        string
+	Description string
+	Class       string
+	Severity    string
+	Conditions  []htmlCondition
+	Namespaces  []htmlNamespace
+}
+
+type htmlCondition struct {
+	Class       string
+	Severity    string
+	Type        string
+	Reason      string
+	Message     string
+	Remediation string
+	Waiver      *result.Waiver
+}
+
+type htmlNamespace struct {
+	Name      string
+	Requester string
+	Objects   []string
+}
+
+// OutputHTML renders results as a single self-contained HTML document: styles are inline
+// and sections collapse with native <details> elements, so the report needs no external
+// assets or scripts and can be opened in air-gapped environments.
+func OutputHTML(out io.Writer, results []check.CheckExecution, opts HTMLOutputOptions) error {
+	report := buildHTMLReport(results, opts)
+
+	if err := htmlReportTemplate.Execute(out, report); err != nil {
+		return fmt.Errorf("rendering HTML report: %w", err)
+	}
+
+	return nil
+}
+
+func buildHTMLReport(results []check.CheckExecution, opts HTMLOutputOptions) *htmlReport {
+	report := &htmlReport{Versions: opts.VersionInfo}
+	if report.Versions == nil {
+		report.Versions = &VersionInfo{}
+	}
+
+	groupIndex := make(map[string]*htmlGroup)
+
+	for _, exec := range results {
+		if exec.Result == nil {
+			continue
+		}
+
+		r := exec.Result
+		impact := checkMaxImpact(exec)
+
+		group, ok := groupIndex[r.Group]
+		if !ok {
+			group = &htmlGroup{Name: r.Group}
+			groupIndex[r.Group] = group
+			report.Groups = append(report.Groups, group)
+		}
+
+		if impactSortPriority(impact) < impactSortPriority(group.impact) {
+			group.impact = impact
+		}
+
+		hc := htmlCheck{
+			Kind:        r.Kind,
+			Name:        r.Name,
+			Description: r.Spec.Description,
+			Class:       htmlImpactClass(impact),
+			Severity:    severityLabel(impact),
+			Namespaces:  htmlNamespaces(r.ImpactedObjects, opts.NamespaceRequesters),
+		}
+
+		for _, cond := range r.Status.Conditions {
+			report.Summary.Total++
+
+			switch cond.Impact {
+			case result.ImpactProhibited:
+				report.Summary.Prohibited++
+			case result.ImpactBlocking:
+				report.Summary.Failed++
+			case result.ImpactAdvisory:
+				report.Summary.Warnings++
+			case result.ImpactNone:
+				report.Summary.Passed++
+			}
+
+			if cond.IsWaived() {
+				report.Summary.Waived++
+			}
+
+			hc.Conditions = append(hc.Conditions, htmlCondition{
+				Class:       htmlImpactClass(cond.Impact),
+				Severity:    severityLabel(cond.Impact),
+				Type:        cond.Type,
+				Reason:      cond.Reason,
+				Message:     cond.Message,
+				Remediation: cond.Remediation,
+				Waiver:      cond.Waiver,
+			})
+		}
+
+		group.Checks = append(group.Checks, hc)
+	}
+
+	sort.SliceStable(report.Groups, func(i, j int) bool {
+		return groupSortPriority(report.Groups[i].Name) < groupSortPriority(report.Groups[j].Name)
+	})
+
+	for _, group := range report.Groups {
+		group.Class = htmlImpactClass(group.impact)
+	}
+
+	// Waived findings are shown but, like in the table verdict, do not affect the verdict.
+	report.Verdict = htmlVerdictFor(SummarizeFindings(results))
+
+	return report
+}
+
+// htmlNamespaces groups impacted objects by namespace, sorted alphabetically, with
+// cluster-scoped objects (empty namespace) first.
+func htmlNamespaces(objects []metav1.PartialObjectMetadata, requesters map[string]string) []htmlNamespace {
+	nsMap := make(map[string][]string)
+
+	for _, obj := range objects {
+		name := obj.Name
+		if obj.Kind != "" {
+			name = fmt.Sprintf("%s (%s)", obj.Name, obj.Kind)
+		}
+
+		if reason, ok := waivedObjectReason(obj.Annotations); ok {
+			name = fmt.Sprintf("%s [waived: %s]", name, reason)
+		}
+
+		nsMap[obj.Namespace] = append(nsMap[obj.Namespace], name)
+	}
+
+	names := make([]string, 0, len(nsMap))
+	for ns := range nsMap {
+		names = append(names, ns)
+	}
+
+	sort.Strings(names)
+
+	namespaces := make([]htmlNamespace, 0, len(names))
+	for _, ns := range names {
+		namespaces = append(namespaces, htmlNamespace{
+			Name:      ns,
+			Requester: requesters[ns],
+			Objects:   nsMap[ns],
+		})
+	}
+
+	return namespaces
+}
+
+// htmlVerdictFor returns the verdict of findings, worded and detailed like printVerdict.
+func htmlVerdictFor(findings Findings) htmlVerdict {
+	lines := incompleteLines(findings)
+
+	switch verdictName(findings) {
+	case verdictProhibited:
+		return htmlVerdict{Class: htmlClassProhibited, Label: verdictProhibited, Text: "upgrade is not possible", Details: lines}
+	case verdictFail:
+		return htmlVerdict{Class: htmlClassBlocking, Label: verdictFail, Text: "blocking findings detected", Details: lines}
+	case verdictWarning:
+		return htmlVerdict{Class: htmlClassAdvisory, Label: verdictWarning, Text: "advisory findings detected", Details: lines}
+	case verdictIncomplete:
+		return htmlVerdict{Class: htmlClassIncomplete, Label: verdictIncomplete, Text: lines[0], Details: lines[1:]}
+	}
+
+	return htmlVerdict{Class: htmlClassPass, Label: verdictPass, Text: "all checks passed"}
+}
+
+func htmlImpactClass(impact result.Impact) string {
+	switch impact {
+	case result.ImpactProhibited:
+		return htmlClassProhibited
+	case result.ImpactBlocking:
+		return htmlClassBlocking
+	case result.ImpactAdvisory:
+		return htmlClassAdvisory
+	case result.ImpactNone:
+		return htmlClassPass
+	}
+
+	return htmlClassPass
+}
+
+// severityLabel returns the severity label used by the table output, without colors.
+func severityLabel(impact result.Impact) string {
+	cond := result.Condition{Impact: impact}
+
+	return getImpactString(&cond, "prohibited", "critical", "warning", "info")
+}
+
+//nolint:gochecknoglobals // Parsed once; the template is static.
+var htmlReportTemplate = template.Must(template.New("report").Parse(htmlReportSource))
+
+const htmlReportSource = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>OpenShift AI Upgrade Readiness Report</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2rem auto; max-width: 1100px; padding: 0 1rem; color: #151515; }
+h1 { font-size: 1.6rem; margin-bottom: 0.5rem; }
+table { border-collapse: collapse; width: 100%; margin: 0.5rem 0; }
+th, td { text-align: left; padding: 0.4rem 0.6rem; border-bottom: 1px solid #d2d2d2; vertical-align: top; }
+th { background: #f0f0f0; }
+.env td:first-child { font-weight: 600; width: 14rem; }
+.verdict { padding: 1rem; border-radius: 4px; font-size: 1.2rem; margin: 1rem 0; color: #fff; }
+.verdict strong { margin-right: 0.5rem; }
+.verdict.prohibited { background: #7d1007; }
+.verdict.blocking { background: #c9190b; }
+.verdict.advisory { background: #f0ab00; color: #151515; }
+.verdict.incomplete { background: #6753ac; }
+.verdict.pass { background: #3e8635; }
+.summary span { margin-right: 1.5rem; }
+details { border: 1px solid #d2d2d2; border-radius: 4px; margin: 0.75rem 0; }
+details > summary { cursor: pointer; padding: 0.6rem 0.8rem; font-weight: 600; background: #fafafa; }
+details[open] > summary { border-bottom: 1px solid #d2d2d2; }
+.group > .body { padding: 0.5rem 0.8rem; }
+.check { margin: 0.75rem 0 1.25rem; }
+.check h3 { font-size: 1.05rem; margin: 0 0 0.25rem; }
+.description { color: #4f5255; margin: 0 0 0.5rem; }
+.badge { display: inline-block; padding: 0.1rem 0.5rem; border-radius: 3px; font-size: 0.8rem; font-weight: 600; color: #fff; }
+.badge.prohibited { background: #7d1007; }
+.badge.blocking { background: #c9190b; }
+.badge.advisory { background: #f0ab00; color: #151515; }
+.badge.pass { background: #3e8635; }
+.remediation { background: #f2f9f9; border-left: 3px solid #009596; padding: 0.4rem 0.6rem; margin-top: 0.3rem; white-space: pre-wrap; }
+.objects { margin-top: 0.5rem; }
+.objects > summary { font-weight: normal; }
+.objects > .body { padding: 0.4rem 0.8rem; }
+.objects ul { margin: 0.25rem 0 0.5rem; }
+.requester { color: #4f5255; font-weight: normal; }
+.waived { background: #f0f0f0; border-left: 3px solid #6a6e73; padding: 0.4rem 0.6rem; margin-top: 0.3rem; }
+</style>
+</head>
+<body>
+<h1>OpenShift AI Upgrade Readiness Report</h1>
+
+<table class="env">
+<tr><td>OpenShift AI version</td><td>{{ .Versions.RHOAICurrentVersion }}{{ with .Versions.RHOAITargetVersion }} &rarr; {{ . }}{{ end }}</td></tr>
+{{- with .Versions.OpenShiftVersion }}
+<tr><td>OpenShift version</td><td>{{ . }}</td></tr>
+{{- end }}
+{{- with .Versions.WorkloadScope }}
+<tr><td>Workload scope</td><td>{{ . }}</td></tr>
+{{- end }}
+</table>
+
+<div class="verdict {{ .Verdict.Class }}"><strong>{{ .Verdict.Label }}</strong>{{ .Verdict.Text }}{{ range .Verdict.Details }}<br>{{ . }}{{ end }}</div>
+
+<p class="summary">
+<span>Total: {{ .Summary.Total }}</span>
+<span>Passed: {{ .Summary.Passed }}</span>
+<span>Warnings: {{ .Summary.Warnings }}</span>
+<span>Failed: {{ .Summary.Failed }}</span>
+<span>Prohibited: {{ .Summary.Prohibited }}</span>
+{{- if .Summary.Waived }}
+<span>Waived: {{ .Summary.Waived }}</span>
+{{- end }}
+</p>
+
+{{- range .Groups }}
+<details class="group"{{ if ne .Class "pass" }} open{{ end }}>
+<summary>{{ .Name }} <span class="badge {{ .Class }}">{{ len .Checks }} checks</span></summary>
+<div class="body">
+{{- range .Checks }}
+<div class="check">
+<h3><span class="badge {{ .Class }}">{{ .Severity }}</span> {{ .Kind }} / {{ .Name }}</h3>
+{{- with .Description }}
+<p class="description">{{ . }}</p>
+{{- end }}
+<table>
+<tr><th>Impact</th><th>Condition</th><th>Message</th></tr>
+{{- range .Conditions }}
+<tr>
+<td><span class="badge {{ .Class }}">{{ .Severity }}</span></td>
+<td>{{ .Type }}<br><small>{{ .Reason }}</small></td>
+<td>{{ .Message }}{{ with .Remediation }}<div class="remediation">{{ . }}</div>{{ end }}{{ with .Waiver }}<div class="waived">Waived until {{ .Expires }} (was {{ .OriginalImpact }}): {{ .Reason }}</div>{{ end }}</td>
+</tr>
+{{- end }}
+</table>
+{{- with .Namespaces }}
+<details class="objects">
+<summary>Impacted objects</summary>
+<div class="body">
+{{- range . }}
+{{- if .Name }}
+<strong>{{ .Name }}</strong>{{ with .Requester }} <span class="requester">(requester: {{ . }})</span>{{ end }}
+{{- else }}
+<strong>Cluster-scoped</strong>
+{{- end }}
+<ul>
+{{- range .Objects }}
+<li>{{ . }}</li>
+{{- end }}
+</ul>
+{{- end }}
+</div>
+</details>
+{{- end }}
+</div>
+{{- end }}
+</div>
+</details>
+{{- end }}
+</body>
+</html>
+`
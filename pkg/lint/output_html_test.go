@@ -0,0 +1,129 @@
+package lint_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestOutputHTML(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	err := lint.OutputHTML(&buf, ciReportResults(), lint.HTMLOutputOptions{
+		VersionInfo: &lint.VersionInfo{
+			RHOAICurrentVersion: "2.25.0",
+			RHOAITargetVersion:  "3.0.0",
+			OpenShiftVersion:    "4.19.1",
+		},
+		NamespaceRequesters: map[string]string{"ns1": "alice@example.com"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	output := buf.String()
+
+	t.Run("includes versions, verdict and summary", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(output).To(ContainSubstring("2.25.0 &rarr; 3.0.0"))
+		g.Expect(output).To(ContainSubstring("<td>4.19.1</td>"))
+		g.Expect(output).To(ContainSubstring(`<div class="verdict blocking"><strong>FAIL</strong>`))
+		g.Expect(output).To(ContainSubstring("Total: 4"))
+		g.Expect(output).To(ContainSubstring("Failed: 2"))
+	})
+
+	t.Run("renders a collapsible section per group in canonical order", func(t *testing.T) {
+		g := NewWithT(t)
+
+		component := strings.Index(output, "<summary>component ")
+		workload := strings.Index(output, "<summary>workload ")
+		g.Expect(component).To(BeNumerically(">", 0))
+		g.Expect(workload).To(BeNumerically(">", component))
+
+		// Passing groups start collapsed, groups with findings expanded.
+		g.Expect(output).To(ContainSubstring(`<details class="group">` + "\n<summary>component "))
+		g.Expect(output).To(ContainSubstring(`<details class="group" open>` + "\n<summary>workload "))
+	})
+
+	t.Run("lists impacted objects by namespace with requester and remediation", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(output).To(ContainSubstring("<strong>ns1</strong> <span class=\"requester\">(requester: alice@example.com)</span>"))
+		g.Expect(output).To(ContainSubstring("<li>isvc-1 (InferenceService)</li>"))
+		g.Expect(output).To(ContainSubstring(`<div class="remediation">Migrate to RawDeployment</div>`))
+	})
+
+	t.Run("has no external assets", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(output).ToNot(ContainSubstring("<script"))
+		g.Expect(output).ToNot(ContainSubstring("<link"))
+		g.Expect(output).ToNot(ContainSubstring("src="))
+		g.Expect(output).ToNot(ContainSubstring("http"))
+	})
+}
+
+func TestOutputHTML_Verdict(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		results  []check.CheckExecution
+		expected string
+	}{
+		{
+			name:     "execution errors only are incomplete",
+			results:  []check.CheckExecution{{Result: diffResult("notebook", result.ImpactNone)}, executionErrorResult()},
+			expected: `<div class="verdict incomplete"><strong>INCOMPLETE</strong>1 check(s) could not be executed</div>`,
+		},
+		{
+			name:    "not executed checks are incomplete",
+			results: []check.CheckExecution{{Result: diffResult("notebook", result.ImpactNone)}, notExecutedResult()},
+			expected: `<div class="verdict incomplete"><strong>INCOMPLETE</strong>` +
+				`1 check(s) were not executed: the run did not complete</div>`,
+		},
+		{
+			name:     "findings are listed with the checks that could not be executed",
+			results:  []check.CheckExecution{{Result: diffResult("notebook", result.ImpactAdvisory)}, executionErrorResult()},
+			expected: `<div class="verdict advisory"><strong>WARNING</strong>advisory findings detected<br>1 check(s) could not be executed</div>`,
+		},
+		{
+			name:    "findings are listed with the checks that did not run",
+			results: []check.CheckExecution{{Result: diffResult("notebook", result.ImpactAdvisory)}, notExecutedResult()},
+			expected: `<div class="verdict advisory"><strong>WARNING</strong>advisory findings detected` +
+				`<br>1 check(s) were not executed: the run did not complete</div>`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var buf bytes.Buffer
+			g.Expect(lint.OutputHTML(&buf, tc.results, lint.HTMLOutputOptions{})).To(Succeed())
+			g.Expect(buf.String()).To(ContainSubstring(tc.expected))
+		})
+	}
+}
+
+func TestOutputHTML_EscapesContent(t *testing.T) {
+	g := NewWithT(t)
+
+	results := ciReportResults()
+	results[1].Result.Status.Conditions[0].Message = `<img src=x onerror="alert(1)">`
+	results[1].Result.ImpactedObjects = append(results[1].Result.ImpactedObjects, metav1.PartialObjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "<b>ns</b>", Name: "obj"},
+	})
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputHTML(&buf, results, lint.HTMLOutputOptions{})).To(Succeed())
+
+	output := buf.String()
+	g.Expect(output).ToNot(ContainSubstring("<img"))
+	g.Expect(output).ToNot(ContainSubstring("<b>ns</b>"))
+	g.Expect(output).To(ContainSubstring("&lt;img src=x"))
+}
@@ -0,0 +1,253 @@
+package lint
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+const junitSuitesName = "odh-lint"
+
+// JUnit XML document types, following the schema understood by Jenkins, Tekton and GitLab.
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	TestCases  []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name       string          `xml:"name,attr"`
+	ClassName  string          `xml:"classname,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Failure    *junitProblem   `xml:"failure,omitempty"`
+	Error      *junitProblem   `xml:"error,omitempty"`
+	Skipped    *junitSkipped   `xml:"skipped,omitempty"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+type junitProblem struct {
+	Type    string `xml:"type,attr"`
+	Message string `xml:"message,attr"`
+	Body    string `xml:",chardata"`
+}
+
+// OutputJUnit outputs diagnostic results as a JUnit XML report.
+//
+// Each check result is a testcase in the testsuite of its group. A result with a condition
+// whose status is Unknown (the check could not be executed) is reported as an error; one
+// with a False condition is reported as a failure whose type is the result's impact level.
+// Every failing condition, and each impacted object, is listed in the failure body;
+// impacted objects are also recorded as testcase properties. A result whose failing
+// conditions are all waived is reported as skipped, with the waiver reason as message.
+func OutputJUnit(
+	out io.Writer,
+	results []check.CheckExecution,
+	clusterVersion *string,
+	targetVersion *string,
+	openShiftVersion *string,
+) error {
+	list := newDiagnosticResultList(results, clusterVersion, targetVersion, openShiftVersion)
+
+	data, err := xml.MarshalIndent(toJUnit(list), "", "  ")
+	if err != nil {
+		return fmt.Errorf("rendering JUnit output: %w", err)
+	}
+
+	if _, err := fmt.Fprintf(out, "%s%s\n", xml.Header, data); err != nil {
+		return fmt.Errorf("writing JUnit output: %w", err)
+	}
+
+	return nil
+}
+
+func toJUnit(list *result.DiagnosticResultList) *junitTestSuites {
+	suites := &junitTestSuites{Name: junitSuitesName}
+	suiteIndex := make(map[string]int)
+
+	for _, r := range list.Results {
+		idx, ok := suiteIndex[r.Group]
+		if !ok {
+			idx = len(suites.Suites)
+			suiteIndex[r.Group] = idx
+			suites.Suites = append(suites.Suites, junitTestSuite{
+				Name:       r.Group,
+				Properties: versionProperties(list),
+			})
+		}
+
+		tc := toJUnitTestCase(r)
+		suite := &suites.Suites[idx]
+		suite.TestCases = append(suite.TestCases, tc)
+		suite.Tests++
+		suites.Tests++
+
+		switch {
+		case tc.Error != nil:
+			suite.Errors++
+			suites.Errors++
+		case tc.Failure != nil:
+			suite.Failures++
+			suites.Failures++
+		case tc.Skipped != nil:
+			suite.Skipped++
+			suites.Skipped++
+		}
+	}
+
+	return suites
+}
+
+func toJUnitTestCase(r *result.DiagnosticResult) junitTestCase {
+	tc := junitTestCase{
+		Name:      r.Name,
+		ClassName: r.Group + "." + r.Kind,
+	}
+
+	for _, obj := range r.ImpactedObjects {
+		tc.Properties = append(tc.Properties, junitProperty{
+			Name:  "impactedObject",
+			Value: impactedObjectRef(obj),
+		})
+	}
+
+	var body strings.Builder
+	executionFailed := false
+	waiverReason := ""
+	allWaived := true
+
+	for _, cond := range r.Status.Conditions {
+		if cond.Status == metav1.ConditionTrue {
+			continue
+		}
+
+		if cond.Status == metav1.ConditionUnknown {
+			executionFailed = true
+		}
+
+		if cond.Waiver != nil {
+			waiverReason = cond.Waiver.Reason
+		} else {
+			allWaived = false
+		}
+
+		fmt.Fprintf(&body, "[%s] %s (%s): %s\n", cond.Impact, cond.Type, cond.Reason, cond.Message)
+
+		if cond.Waiver != nil {
+			fmt.Fprintf(&body, "  Waived: %s (expires %s)\n", cond.Waiver.Reason, cond.Waiver.Expires)
+		}
+
+		if cond.Remediation != "" {
+			fmt.Fprintf(&body, "  Remediation: %s\n", cond.Remediation)
+		}
+	}
+
+	if body.Len() == 0 {
+		return tc
+	}
+
+	if len(r.ImpactedObjects) > 0 {
+		body.WriteString("Impacted objects:\n")
+
+		for _, obj := range r.ImpactedObjects {
+			if reason, ok := waivedObjectReason(obj.Annotations); ok {
+				fmt.Fprintf(&body, "  - %s (waived: %s)\n", impactedObjectRef(obj), reason)
+			} else {
+				fmt.Fprintf(&body, "  - %s\n", impactedObjectRef(obj))
+			}
+		}
+	}
+
+	if allWaived {
+		tc.Skipped = &junitSkipped{Message: "waived: " + waiverReason}
+
+		return tc
+	}
+
+	problem := &junitProblem{
+		Type:    string(r.GetImpact()),
+		Message: failingMessage(r),
+		Body:    body.String(),
+	}
+
+	if executionFailed {
+		tc.Error = problem
+	} else {
+		tc.Failure = problem
+	}
+
+	return tc
+}
+
+// failingMessage returns the message of the first condition that is not met.
+func failingMessage(r *result.DiagnosticResult) string {
+	for _, cond := range r.Status.Conditions {
+		if cond.Status != metav1.ConditionTrue {
+			return cond.Message
+		}
+	}
+
+	return r.GetMessage()
+}
+
+func versionProperties(list *result.DiagnosticResultList) []junitProperty {
+	var props []junitProperty
+
+	for _, p := range []struct {
+		name  string
+		value *string
+	}{
+		{"clusterVersion", list.ClusterVersion},
+		{"targetVersion", list.TargetVersion},
+		{"openShiftVersion", list.OpenShiftVersion},
+	} {
+		if p.value != nil && *p.value != "" {
+			props = append(props, junitProperty{Name: p.name, Value: *p.value})
+		}
+	}
+
+	return props
+}
+
+// impactedObjectRef formats an impacted object as Kind/namespace/name, omitting the namespace
+// for cluster-scoped objects.
+func impactedObjectRef(obj metav1.PartialObjectMetadata) string {
+	parts := make([]string, 0, 3)
+
+	if obj.Kind != "" {
+		parts = append(parts, obj.Kind)
+	}
+
+	if obj.Namespace != "" {
+		parts = append(parts, obj.Namespace)
+	}
+
+	return strings.Join(append(parts, obj.Name), "/")
+}
@@ -0,0 +1,169 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+// ciReportResults returns one passing, one blocking, one advisory and one errored result,
+// shared by the JUnit and SARIF output tests.
+func ciReportResults() []check.CheckExecution {
+	return []check.CheckExecution{
+		{
+			Result: &result.DiagnosticResult{
+				Group:  "component",
+				Kind:   "dashboard",
+				Name:   "removal",
+				Spec:   result.DiagnosticSpec{Description: "dashboard is supported"},
+				Status: result.DiagnosticStatus{Conditions: []result.Condition{passCondition()}},
+			},
+		},
+		{
+			Result: &result.DiagnosticResult{
+				Group: "workload",
+				Kind:  "kserve",
+				Name:  "impacted-workloads",
+				Spec:  result.DiagnosticSpec{Description: "serverless InferenceServices must be migrated"},
+				Status: result.DiagnosticStatus{Conditions: []result.Condition{{
+					Condition: metav1.Condition{
+						Type:    "Compatible",
+						Status:  metav1.ConditionFalse,
+						Reason:  "ServerlessDetected",
+						Message: "2 serverless InferenceServices found",
+					},
+					Impact:      result.ImpactBlocking,
+					Remediation: "Migrate to RawDeployment",
+				}}},
+				ImpactedObjects: []metav1.PartialObjectMetadata{
+					{
+						TypeMeta:   metav1.TypeMeta{Kind: "InferenceService", APIVersion: "serving.kserve.io/v1beta1"},
+						ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "isvc-1"},
+					},
+				},
+			},
+		},
+		{
+			Result: &result.DiagnosticResult{
+				Group: "workload",
+				Kind:  "notebook",
+				Name:  "image-deprecation",
+				Status: result.DiagnosticStatus{Conditions: []result.Condition{{
+					Condition: metav1.Condition{
+						Type:    "Compatible",
+						Status:  metav1.ConditionFalse,
+						Reason:  "DeprecatedImage",
+						Message: "deprecated image in use",
+					},
+					Impact: result.ImpactAdvisory,
+				}}},
+			},
+		},
+		{
+			Result: &result.DiagnosticResult{
+				Group: "workload",
+				Kind:  "ray",
+				Name:  "codeflare",
+				Status: result.DiagnosticStatus{Conditions: []result.Condition{{
+					Condition: metav1.Condition{
+						Type:    "Validated",
+						Status:  metav1.ConditionUnknown,
+						Reason:  "CheckExecutionFailed",
+						Message: "listing RayClusters: forbidden",
+					},
+					Impact: result.ImpactBlocking,
+				}}},
+			},
+		},
+	}
+}
+
+type junitReport struct {
+	Tests    int `xml:"tests,attr"`
+	Failures int `xml:"failures,attr"`
+	Errors   int `xml:"errors,attr"`
+	Suites   []struct {
+		Name      string `xml:"name,attr"`
+		TestCases []struct {
+			Name       string `xml:"name,attr"`
+			ClassName  string `xml:"classname,attr"`
+			Properties []struct {
+				Name  string `xml:"name,attr"`
+				Value string `xml:"value,attr"`
+			} `xml:"properties>property"`
+			Failure *struct {
+				Type    string `xml:"type,attr"`
+				Message string `xml:"message,attr"`
+				Body    string `xml:",chardata"`
+			} `xml:"failure"`
+			Error *struct {
+				Message string `xml:"message,attr"`
+			} `xml:"error"`
+		} `xml:"testcase"`
+	} `xml:"testsuite"`
+}
+
+func TestOutputJUnit(t *testing.T) {
+	g := NewWithT(t)
+
+	clusterVersion := "2.25.0"
+	targetVersion := "3.0.0"
+
+	var buf bytes.Buffer
+	err := lint.OutputJUnit(&buf, ciReportResults(), &clusterVersion, &targetVersion, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(buf.String()).To(HavePrefix(xml.Header))
+
+	var report junitReport
+	g.Expect(xml.Unmarshal(buf.Bytes(), &report)).To(Succeed())
+
+	g.Expect(report.Tests).To(Equal(4))
+	g.Expect(report.Failures).To(Equal(2))
+	g.Expect(report.Errors).To(Equal(1))
+
+	g.Expect(report.Suites).To(HaveLen(2))
+	g.Expect(report.Suites[0].Name).To(Equal("component"))
+	g.Expect(report.Suites[1].Name).To(Equal("workload"))
+
+	t.Run("passing check has no failure", func(t *testing.T) {
+		g := NewWithT(t)
+
+		tc := report.Suites[0].TestCases[0]
+		g.Expect(tc.ClassName).To(Equal("component.dashboard"))
+		g.Expect(tc.Name).To(Equal("removal"))
+		g.Expect(tc.Failure).To(BeNil())
+		g.Expect(tc.Error).To(BeNil())
+	})
+
+	t.Run("failure carries impact and impacted objects", func(t *testing.T) {
+		g := NewWithT(t)
+
+		tc := report.Suites[1].TestCases[0]
+		g.Expect(tc.Failure).ToNot(BeNil())
+		g.Expect(tc.Failure.Type).To(Equal("blocking"))
+		g.Expect(tc.Failure.Message).To(Equal("2 serverless InferenceServices found"))
+		g.Expect(tc.Failure.Body).To(ContainSubstring("Remediation: Migrate to RawDeployment"))
+		g.Expect(tc.Failure.Body).To(ContainSubstring("InferenceService/ns1/isvc-1"))
+		g.Expect(tc.Properties).To(HaveLen(1))
+		g.Expect(tc.Properties[0].Value).To(Equal("InferenceService/ns1/isvc-1"))
+
+		g.Expect(report.Suites[1].TestCases[1].Failure.Type).To(Equal("advisory"))
+	})
+
+	t.Run("execution failure is an error", func(t *testing.T) {
+		g := NewWithT(t)
+
+		tc := report.Suites[1].TestCases[2]
+		g.Expect(tc.Failure).To(BeNil())
+		g.Expect(tc.Error).ToNot(BeNil())
+		g.Expect(tc.Error.Message).To(Equal("listing RayClusters: forbidden"))
+	})
+}
@@ -0,0 +1,236 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/internal/version"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+const (
+	sarifSchema    = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion   = "2.1.0"
+	sarifToolName  = "odh-lint"
+	sarifToolURI   = "https://github.com/opendatahub-io/odh-cli"
+	sarifLevelErr  = "error"
+	sarifLevelWarn = "warning"
+	sarifLevelNone = "none"
+	sarifKindFail  = "fail"
+	sarifKindPass  = "pass"
+
+	// Waivers are kept in a file outside the analyzed cluster.
+	sarifSuppressionExternal = "external"
+	sarifSuppressionAccepted = "accepted"
+)
+
+// SARIF 2.1.0 document types, limited to the properties odh-lint produces.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool       sarifTool         `json:"tool"`
+	Results    []sarifResult     `json:"results"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string        `json:"id"`
+	Name             string        `json:"name,omitempty"`
+	ShortDescription *sarifMessage `json:"shortDescription,omitempty"`
+	Help             *sarifMessage `json:"help,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID       string             `json:"ruleId"`
+	RuleIndex    int                `json:"ruleIndex"`
+	Kind         string             `json:"kind"`
+	Level        string             `json:"level"`
+	Message      sarifMessage       `json:"message"`
+	Locations    []sarifLocation    `json:"locations,omitempty"`
+	Suppressions []sarifSuppression `json:"suppressions,omitempty"`
+	Properties   map[string]any     `json:"properties,omitempty"`
+}
+
+type sarifSuppression struct {
+	Kind          string `json:"kind"`
+	Status        string `json:"status"`
+	Justification string `json:"justification"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+// OutputSARIF outputs diagnostic results as a SARIF 2.1.0 log.
+//
+// Each check result is a rule, identified as group.kind.name like in the JSON output.
+// Every condition becomes a SARIF result: conditions that are met are reported with kind
+// "pass", others with kind "fail" and a level derived from their impact (prohibited and
+// blocking are errors, advisory a warning). Impacted objects are reported as logical
+// locations, since they are cluster objects rather than files. Waived conditions carry an
+// accepted external suppression, and waived objects are listed in the "waivedObjects" property.
+func OutputSARIF(
+	out io.Writer,
+	results []check.CheckExecution,
+	clusterVersion *string,
+	targetVersion *string,
+	openShiftVersion *string,
+) error {
+	list := newDiagnosticResultList(results, clusterVersion, targetVersion, openShiftVersion)
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(toSARIF(list)); err != nil {
+		return fmt.Errorf("rendering SARIF output: %w", err)
+	}
+
+	return nil
+}
+
+func toSARIF(list *result.DiagnosticResultList) *sarifLog {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           sarifToolName,
+			Version:        version.GetVersion(),
+			InformationURI: sarifToolURI,
+			Rules:          make([]sarifRule, 0, len(list.Results)),
+		}},
+		Results:    make([]sarifResult, 0),
+		Properties: make(map[string]string),
+	}
+
+	for _, p := range versionProperties(list) {
+		run.Properties[p.Name] = p.Value
+	}
+
+	for idx, r := range list.Results {
+		// Several checks share the same group, kind and name
+		ruleID := r.CheckID()
+
+		rule := sarifRule{ID: ruleID, Name: r.Name}
+		if r.Spec.Description != "" {
+			rule.ShortDescription = &sarifMessage{Text: r.Spec.Description}
+		}
+
+		if remediation := r.GetRemediation(); remediation != "" {
+			rule.Help = &sarifMessage{Text: remediation}
+		}
+
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, rule)
+
+		for _, cond := range r.Status.Conditions {
+			run.Results = append(run.Results, toSARIFResult(ruleID, idx, r, cond))
+		}
+	}
+
+	return &sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{run},
+	}
+}
+
+func toSARIFResult(ruleID string, ruleIndex int, r *result.DiagnosticResult, cond result.Condition) sarifResult {
+	res := sarifResult{
+		RuleID:    ruleID,
+		RuleIndex: ruleIndex,
+		Kind:      sarifKindPass,
+		Level:     sarifLevelNone,
+		Message:   sarifMessage{Text: cond.Message},
+		Properties: map[string]any{
+			"conditionType": cond.Type,
+			"status":        string(cond.Status),
+			"reason":        cond.Reason,
+		},
+	}
+
+	if cond.Impact != result.ImpactNone {
+		res.Properties["impact"] = string(cond.Impact)
+	}
+
+	if cond.Remediation != "" {
+		res.Properties["remediation"] = cond.Remediation
+	}
+
+	if cond.Status == metav1.ConditionTrue {
+		return res
+	}
+
+	res.Kind = sarifKindFail
+	res.Level = sarifLevel(cond.Impact)
+
+	if cond.Waiver != nil {
+		res.Suppressions = []sarifSuppression{{
+			Kind:          sarifSuppressionExternal,
+			Status:        sarifSuppressionAccepted,
+			Justification: cond.Waiver.Reason,
+		}}
+		res.Properties["waiverExpires"] = cond.Waiver.Expires
+		res.Properties["originalImpact"] = string(cond.Waiver.OriginalImpact)
+	}
+
+	var waivedObjects []string
+
+	for _, obj := range r.ImpactedObjects {
+		if _, ok := waivedObjectReason(obj.Annotations); ok {
+			waivedObjects = append(waivedObjects, impactedObjectRef(obj))
+		}
+
+		res.Locations = append(res.Locations, sarifLocation{
+			LogicalLocations: []sarifLogicalLocation{{
+				Name:               obj.Name,
+				FullyQualifiedName: impactedObjectRef(obj),
+				Kind:               "resource",
+			}},
+		})
+	}
+
+	if len(waivedObjects) > 0 {
+		res.Properties["waivedObjects"] = waivedObjects
+	}
+
+	return res
+}
+
+func sarifLevel(impact result.Impact) string {
+	switch impact {
+	case result.ImpactProhibited, result.ImpactBlocking:
+		return sarifLevelErr
+	case result.ImpactAdvisory:
+		return sarifLevelWarn
+	case result.ImpactNone:
+		return sarifLevelNone
+	}
+
+	return sarifLevelNone
+}
@@ -0,0 +1,128 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+type sarifReport struct {
+	Version string `json:"version"`
+	Runs    []struct {
+		Tool struct {
+			Driver struct {
+				Name  string `json:"name"`
+				Rules []struct {
+					ID               string `json:"id"`
+					ShortDescription *struct {
+						Text string `json:"text"`
+					} `json:"shortDescription"`
+					Help *struct {
+						Text string `json:"text"`
+					} `json:"help"`
+				} `json:"rules"`
+			} `json:"driver"`
+		} `json:"tool"`
+		Results []struct {
+			RuleID    string `json:"ruleId"`
+			RuleIndex int    `json:"ruleIndex"`
+			Kind      string `json:"kind"`
+			Level     string `json:"level"`
+			Locations []struct {
+				LogicalLocations []struct {
+					FullyQualifiedName string `json:"fullyQualifiedName"`
+				} `json:"logicalLocations"`
+			} `json:"locations"`
+			Properties map[string]any `json:"properties"`
+		} `json:"results"`
+		Properties map[string]string `json:"properties"`
+	} `json:"runs"`
+}
+
+func TestOutputSARIF(t *testing.T) {
+	g := NewWithT(t)
+
+	clusterVersion := "2.25.0"
+	targetVersion := "3.0.0"
+
+	var buf bytes.Buffer
+	err := lint.OutputSARIF(&buf, ciReportResults(), &clusterVersion, &targetVersion, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	var report sarifReport
+	g.Expect(json.Unmarshal(buf.Bytes(), &report)).To(Succeed())
+
+	g.Expect(report.Version).To(Equal("2.1.0"))
+	g.Expect(report.Runs).To(HaveLen(1))
+
+	run := report.Runs[0]
+	g.Expect(run.Properties).To(Equal(map[string]string{"clusterVersion": "2.25.0", "targetVersion": "3.0.0"}))
+
+	t.Run("each check is a rule", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rules := run.Tool.Driver.Rules
+		g.Expect(rules).To(HaveLen(4))
+		g.Expect(rules[1].ID).To(Equal("workload.kserve.impacted-workloads"))
+		g.Expect(rules[1].ShortDescription.Text).To(Equal("serverless InferenceServices must be migrated"))
+		g.Expect(rules[1].Help.Text).To(Equal("Migrate to RawDeployment"))
+	})
+
+	t.Run("conditions map to results by impact", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(run.Results).To(HaveLen(4))
+
+		levels := make([]string, 0, len(run.Results))
+		kinds := make([]string, 0, len(run.Results))
+
+		for i, res := range run.Results {
+			g.Expect(res.RuleIndex).To(Equal(i))
+			levels = append(levels, res.Level)
+			kinds = append(kinds, res.Kind)
+		}
+
+		g.Expect(levels).To(Equal([]string{"none", "error", "warning", "error"}))
+		g.Expect(kinds).To(Equal([]string{"pass", "fail", "fail", "fail"}))
+		g.Expect(run.Results[1].Properties).To(HaveKeyWithValue("impact", "blocking"))
+	})
+
+	t.Run("impacted objects are logical locations", func(t *testing.T) {
+		g := NewWithT(t)
+
+		locations := run.Results[1].Locations
+		g.Expect(locations).To(HaveLen(1))
+		g.Expect(locations[0].LogicalLocations[0].FullyQualifiedName).To(Equal("InferenceService/ns1/isvc-1"))
+	})
+}
+
+func TestOutputSARIF_SharedGroupKindName(t *testing.T) {
+	g := NewWithT(t)
+
+	// Both checks report workload.kserve.impacted-workloads
+	executions := []check.CheckExecution{
+		{Result: withCheckID(diffResult("kserve", result.ImpactBlocking, "a"), "workloads.kserve.raw-deployment")},
+		{Result: withCheckID(diffResult("kserve", result.ImpactNone), "workloads.kserve.serverless")},
+	}
+
+	var buf bytes.Buffer
+	g.Expect(lint.OutputSARIF(&buf, executions, nil, nil, nil)).To(Succeed())
+
+	var report sarifReport
+	g.Expect(json.Unmarshal(buf.Bytes(), &report)).To(Succeed())
+
+	g.Expect(report.Runs[0].Tool.Driver.Rules).To(HaveExactElements(
+		HaveField("ID", "workloads.kserve.raw-deployment"),
+		HaveField("ID", "workloads.kserve.serverless"),
+	))
+	g.Expect(report.Runs[0].Results).To(HaveExactElements(
+		HaveField("RuleID", "workloads.kserve.raw-deployment"),
+		HaveField("RuleID", "workloads.kserve.serverless"),
+	))
+}
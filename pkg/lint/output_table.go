@@ -39,24 +39,55 @@ var (
 )
 
 // printVerdict prints the Result section after the summary.
-func printVerdict(out io.Writer, hasProhibited bool, hasBlocking bool, hasAdvisory bool) {
+// Checks that could not be executed, and checks not executed because the run ended, are
+// reported on their own lines, or as the verdict when there are no findings.
+func printVerdict(out io.Writer, findings Findings) {
 	_, _ = fmt.Fprintln(out)
 	_, _ = fmt.Fprintln(out, "Result:")
 
 	switch {
-	case hasProhibited:
+	case findings.Prohibited:
 		verdict := color.New(color.FgRed, color.Bold).Sprint("PROHIBITED")
 		_, _ = fmt.Fprintf(out, "  %s - upgrade is not possible\n", verdict)
-	case hasBlocking:
+	case findings.Blocking:
 		verdict := color.New(color.FgRed, color.Bold).Sprint("FAIL")
 		_, _ = fmt.Fprintf(out, "  %s - blocking findings detected\n", verdict)
-	case hasAdvisory:
+	case findings.Advisory:
 		verdict := color.New(color.FgYellow, color.Bold).Sprint("WARNING")
 		_, _ = fmt.Fprintf(out, "  %s - advisory findings detected\n", verdict)
+	case findings.ExecutionErrors > 0 || findings.NotExecuted > 0:
+		verdict := color.New(color.FgMagenta, color.Bold).Sprint("INCOMPLETE")
+		lines := incompleteLines(findings)
+		_, _ = fmt.Fprintf(out, "  %s - %s\n", verdict, lines[0])
+
+		for _, line := range lines[1:] {
+			_, _ = fmt.Fprintf(out, "  %s\n", line)
+		}
+
+		return
 	default:
 		verdict := color.New(color.FgGreen, color.Bold).Sprint("PASS")
 		_, _ = fmt.Fprintf(out, "  %s - all checks passed\n", verdict)
 	}
+
+	for _, line := range incompleteLines(findings) {
+		_, _ = fmt.Fprintf(out, "  %s\n", line)
+	}
+}
+
+// incompleteLines describes the checks of findings that did not produce a result.
+func incompleteLines(findings Findings) []string {
+	var lines []string
+
+	if findings.ExecutionErrors > 0 {
+		lines = append(lines, fmt.Sprintf("%d check(s) could not be executed", findings.ExecutionErrors))
+	}
+
+	if findings.NotExecuted > 0 {
+		lines = append(lines, fmt.Sprintf("%d check(s) were not executed: the run did not complete", findings.NotExecuted))
+	}
+
+	return lines
 }
 
 // outputProhibitedBanner renders a prominent warning banner above the summary table
@@ -86,6 +117,7 @@ func outputProhibitedBanner(out io.Writer, findings []sortableRow) {
 type sortableRow struct {
 	row    CheckResultTableRow
 	impact result.Impact
+	waived bool
 }
 
 // collectSortedRows builds table rows from check executions and sorts them
@@ -108,6 +140,11 @@ func collectSortedRows(results []check.CheckExecution) []sortableRow {
 		}
 
 		for _, condition := range exec.Result.Status.Conditions {
+			message := condition.Message
+			if condition.Waiver != nil {
+				message += " (waived: " + condition.Waiver.Reason + ")"
+			}
+
 			rows = append(rows, sortableRow{
 				row: CheckResultTableRow{
 					Status:      statusSymbol(condition.Impact),
@@ -115,10 +152,11 @@ func collectSortedRows(results []check.CheckExecution) []sortableRow {
 					Group:       exec.Result.Group,
 					Check:       exec.Result.Name,
 					Impact:      getImpactString(&condition, severityProhibited, severityCrit, severityWarn, severityInfo),
-					Message:     condition.Message,
+					Message:     message,
 					Description: exec.Result.Spec.Description,
 				},
 				impact: condition.Impact,
+				waived: condition.IsWaived(),
 			})
 		}
 	}
@@ -204,10 +242,15 @@ func OutputTable(out io.Writer, results []check.CheckExecution, opts TableOutput
 	totalWarnings := 0
 	totalFailed := 0
 	totalProhibited := 0
+	totalWaived := 0
 
 	for _, sr := range rows {
 		totalChecks++
 
+		if sr.waived {
+			totalWaived++
+		}
+
 		switch sr.impact {
 		case result.ImpactProhibited:
 			totalProhibited++
@@ -235,7 +278,15 @@ func OutputTable(out io.Writer, results []check.CheckExecution, opts TableOutput
 
 	_, _ = fmt.Fprintln(out)
 	_, _ = fmt.Fprintln(out, "Summary:")
-	_, _ = fmt.Fprintf(out, "  Total: %d | Passed: %d | Warnings: %d | Failed: %d | Prohibited: %d\n", totalChecks, totalPassed, totalWarnings, totalFailed, totalProhibited)
+	_, _ = fmt.Fprintf(out, "  Total: %d | Passed: %d | Warnings: %d | Failed: %d | Prohibited: %d", totalChecks, totalPassed, totalWarnings, totalFailed, totalProhibited)
+
+	if totalWaived > 0 {
+		_, _ = fmt.Fprintf(out, " | Waived: %d", totalWaived)
+	}
+
+	_, _ = fmt.Fprintln(out)
+
+	outputWaivedObjects(out, results)
 
 	if opts.ShowImpactedObjects {
 		outputImpactedObjects(out, results, opts.NamespaceRequesters)
@@ -244,6 +295,41 @@ func OutputTable(out io.Writer, results []check.CheckExecution, opts TableOutput
 	return nil
 }
 
+// outputWaivedObjects lists the impacted objects covered by waivers, per check.
+func outputWaivedObjects(out io.Writer, results []check.CheckExecution) {
+	headerPrinted := false
+
+	for _, exec := range results {
+		if exec.Result == nil {
+			continue
+		}
+
+		checkPrinted := false
+
+		for _, obj := range exec.Result.ImpactedObjects {
+			reason, ok := waivedObjectReason(obj.Annotations)
+			if !ok {
+				continue
+			}
+
+			if !headerPrinted {
+				_, _ = fmt.Fprintln(out)
+				_, _ = fmt.Fprintln(out, "Waived Objects:")
+
+				headerPrinted = true
+			}
+
+			if !checkPrinted {
+				_, _ = fmt.Fprintf(out, "  %s / %s / %s\n", exec.Result.Group, exec.Result.Kind, exec.Result.Name)
+
+				checkPrinted = true
+			}
+
+			_, _ = fmt.Fprintf(out, "    %s: %s\n", impactedObjectRef(obj), reason)
+		}
+	}
+}
+
 // outputVersionInfo prints the Environment section with version details.
 func outputVersionInfo(out io.Writer, info *VersionInfo) {
 	_, _ = fmt.Fprintln(out, "Environment:")
@@ -257,6 +343,10 @@ func outputVersionInfo(out io.Writer, info *VersionInfo) {
 	if info.OpenShiftVersion != "" {
 		_, _ = fmt.Fprintf(out, "  OpenShift version:    %s\n", info.OpenShiftVersion)
 	}
+
+	if info.WorkloadScope != "" {
+		_, _ = fmt.Fprintf(out, "  Workload scope:       %s\n", info.WorkloadScope)
+	}
 }
 
 // namespaceRequesterSetter is implemented by verbose formatters that need
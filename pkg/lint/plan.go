@@ -0,0 +1,187 @@
+package lint
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/printer/table"
+	"github.com/opendatahub-io/odh-cli/pkg/upgrade"
+)
+
+//nolint:gochecknoglobals
+var planTableHeaders = []string{
+	"HOP", "FROM", "TO", "OPENSHIFT", "PROHIBITED", "BLOCKING", "ADVISORY", "ERRORS", "VERDICT",
+}
+
+// Plan is the outcome of assessing every hop of an upgrade path.
+type Plan struct {
+	// ClusterVersion is the detected OpenShift AI version.
+	ClusterVersion string
+
+	// OpenShiftVersion is the detected OpenShift platform version, empty if unknown.
+	OpenShiftVersion string
+
+	// Hops are the hops of the path in upgrade order.
+	Hops []HopResult
+}
+
+// HopResult holds the check results of one hop of an upgrade path.
+type HopResult struct {
+	upgrade.Hop
+
+	// Results are the check results, with the severity filter applied.
+	Results []check.CheckExecution
+}
+
+// PlanReport is the JSON/YAML output of upgrade plan.
+type PlanReport struct {
+	ClusterVersion   string      `json:"clusterVersion"             yaml:"clusterVersion"`
+	OpenShiftVersion *string     `json:"openShiftVersion,omitempty" yaml:"openShiftVersion,omitempty"`
+	Hops             []HopReport `json:"hops"                       yaml:"hops"`
+}
+
+// HopReport is one hop of a PlanReport. Blocked is set when the hop has prohibited or
+// blocking findings.
+type HopReport struct {
+	From                string                     `json:"from"                yaml:"from"`
+	To                  string                     `json:"to"                  yaml:"to"`
+	MinOpenShiftVersion string                     `json:"minOpenShiftVersion" yaml:"minOpenShiftVersion"`
+	Blocked             bool                       `json:"blocked"             yaml:"blocked"`
+	Results             []*result.DiagnosticResult `json:"results"             yaml:"results"`
+}
+
+// Findings combines the findings of every hop for the plan verdict.
+func (p *Plan) Findings() Findings {
+	var plan Findings
+
+	for _, hop := range p.Hops {
+		f := SummarizeFindings(hop.Results)
+		plan.Prohibited = plan.Prohibited || f.Prohibited
+		plan.Blocking = plan.Blocking || f.Blocking
+		plan.Advisory = plan.Advisory || f.Advisory
+		plan.ExecutionErrors += f.ExecutionErrors
+		plan.NotExecuted += f.NotExecuted
+	}
+
+	return plan
+}
+
+// Report builds the structured plan output.
+func (p *Plan) Report() *PlanReport {
+	report := &PlanReport{
+		ClusterVersion:   p.ClusterVersion,
+		OpenShiftVersion: stringPtrOrNil(p.OpenShiftVersion),
+		Hops:             make([]HopReport, 0, len(p.Hops)),
+	}
+
+	for _, hop := range p.Hops {
+		findings := SummarizeFindings(hop.Results)
+
+		hr := HopReport{
+			From:                hop.From.String(),
+			To:                  hop.To.String(),
+			MinOpenShiftVersion: hop.MinOpenShiftVersion.String(),
+			Blocked:             findings.Prohibited || findings.Blocking,
+			Results:             make([]*result.DiagnosticResult, 0, len(hop.Results)),
+		}
+
+		for _, exec := range hop.Results {
+			hr.Results = append(hr.Results, exec.Result)
+		}
+
+		report.Hops = append(report.Hops, hr)
+	}
+
+	return report
+}
+
+// planTableRow is a single row of the upgrade plan matrix.
+type planTableRow struct {
+	Hop        string
+	From       string
+	To         string
+	OpenShift  string
+	Prohibited string
+	Blocking   string
+	Advisory   string
+	Errors     string
+	Verdict    string
+}
+
+// OutputPlanTable prints the upgrade path, a verdict matrix with one row per hop and,
+// for every hop, the findings reported for it.
+func OutputPlanTable(out io.Writer, plan *Plan) error {
+	path := []string{plan.ClusterVersion}
+	for _, hop := range plan.Hops {
+		path = append(path, hop.To.String())
+	}
+
+	_, _ = fmt.Fprintf(out, "Upgrade path: %s\n\n", strings.Join(path, " → "))
+
+	renderer := table.NewRenderer[planTableRow](
+		table.WithWriter[planTableRow](out),
+		table.WithHeaders[planTableRow](planTableHeaders...),
+		table.WithTableOptions[planTableRow](table.DefaultTableOptions...),
+	)
+
+	for i, hop := range plan.Hops {
+		counts := countFindings(hop.Results)
+		findings := SummarizeFindings(hop.Results)
+
+		row := planTableRow{
+			Hop:        strconv.Itoa(i + 1),
+			From:       hop.From.String(),
+			To:         hop.To.String(),
+			OpenShift:  hop.MinOpenShiftVersion.String() + "+",
+			Prohibited: strconv.Itoa(counts[result.ImpactProhibited]),
+			Blocking:   strconv.Itoa(counts[result.ImpactBlocking]),
+			Advisory:   strconv.Itoa(counts[result.ImpactAdvisory]),
+			Errors:     strconv.Itoa(findings.ExecutionErrors + findings.NotExecuted),
+			Verdict:    verdictLabel(findings),
+		}
+
+		if err := renderer.Append(row); err != nil {
+			return fmt.Errorf("appending table row: %w", err)
+		}
+	}
+
+	if err := renderer.Render(); err != nil {
+		return fmt.Errorf("rendering table: %w", err)
+	}
+
+	for i, hop := range plan.Hops {
+		printHopFindings(out, i+1, hop)
+	}
+
+	return nil
+}
+
+// printHopFindings lists the conditions of a hop that have an impact or could not be
+// evaluated, most severe first.
+func printHopFindings(out io.Writer, index int, hop HopResult) {
+	rows := collectSortedRows(hop.Results)
+
+	var lines []string
+
+	for _, r := range rows {
+		if r.impact == result.ImpactNone || r.waived {
+			continue
+		}
+
+		lines = append(lines, fmt.Sprintf("  %s  [%s / %s] %s", r.row.Impact, r.row.Group, r.row.Check, r.row.Message))
+	}
+
+	if len(lines) == 0 {
+		return
+	}
+
+	_, _ = fmt.Fprintf(out, "\nHop %d (%s → %s):\n", index, hop.From.String(), hop.To.String())
+
+	for _, line := range lines {
+		_, _ = fmt.Fprintln(out, line)
+	}
+}
@@ -0,0 +1,274 @@
+package lint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+const (
+	// ReportConfigMapName is the name of the ConfigMap lint --publish writes to the
+	// applications namespace.
+	ReportConfigMapName = "odh-upgrade-readiness"
+
+	// Keys of the published ConfigMap.
+	ReportKeySummary    = "summary.json"
+	ReportKeyConditions = "conditions.json"
+	ReportKeyResults    = "results.json"
+
+	// publishTimeout bounds publishing, which also runs after the run timed out.
+	publishTimeout = 30 * time.Second
+)
+
+// ReadinessSummary counts the checks of a published report by outcome. Each check is
+// counted once, by its most severe unwaived finding.
+type ReadinessSummary struct {
+	ClusterVersion  string `json:"clusterVersion,omitempty"`
+	TargetVersion   string `json:"targetVersion,omitempty"`
+	Verdict         string `json:"verdict"`
+	Prohibited      int    `json:"prohibited"`
+	Blocking        int    `json:"blocking"`
+	Advisory        int    `json:"advisory"`
+	Passed          int    `json:"passed"`
+	ExecutionErrors int    `json:"executionErrors"`
+	NotExecuted     int    `json:"notExecuted"`
+}
+
+// ReadinessCheck is the published result of a check. The impacted objects are only
+// counted, so that the report stays within the size limit of a ConfigMap on clusters
+// with many workloads.
+type ReadinessCheck struct {
+	ID              string             `json:"id"`
+	Group           string             `json:"group"`
+	Kind            string             `json:"kind"`
+	Name            string             `json:"name"`
+	Annotations     map[string]string  `json:"annotations,omitempty"`
+	Conditions      []result.Condition `json:"conditions"`
+	ImpactedObjects int                `json:"impactedObjects"`
+}
+
+// ReadinessReport is the content of the published ConfigMap: the summary, one
+// metav1.Condition per check and the results of the checks.
+type ReadinessReport struct {
+	Summary    ReadinessSummary
+	Conditions []metav1.Condition
+	Results    []ReadinessCheck
+}
+
+// NewReadinessReport builds the report of list. The conditions of a previous report keep
+// their LastTransitionTime while their status is unchanged, so republishing the same
+// results does not change them.
+func NewReadinessReport(list *result.DiagnosticResultList, previous []metav1.Condition) *ReadinessReport {
+	report := &ReadinessReport{
+		Summary: ReadinessSummary{
+			ClusterVersion: stringValue(list.ClusterVersion),
+			TargetVersion:  stringValue(list.TargetVersion),
+		},
+		Results: make([]ReadinessCheck, 0, len(list.Results)),
+	}
+
+	executions := make([]check.CheckExecution, 0, len(list.Results))
+	current := make(map[string]bool, len(list.Results))
+
+	for _, r := range list.Results {
+		executions = append(executions, check.CheckExecution{Result: r})
+		report.Results = append(report.Results, ReadinessCheck{
+			ID:              r.CheckID(),
+			Group:           r.Group,
+			Kind:            r.Kind,
+			Name:            r.Name,
+			Annotations:     r.Annotations,
+			Conditions:      r.Status.Conditions,
+			ImpactedObjects: len(r.ImpactedObjects),
+		})
+
+		cond, impact := readinessCondition(r)
+		current[cond.Type] = true
+
+		report.Summary.count(cond, impact)
+		apimeta.SetStatusCondition(&previous, cond)
+	}
+
+	report.Summary.Verdict = verdictName(SummarizeFindings(executions))
+
+	// Checks that no longer report a result are dropped.
+	report.Conditions = slices.DeleteFunc(previous, func(c metav1.Condition) bool {
+		return !current[c.Type]
+	})
+
+	slices.SortFunc(report.Conditions, func(a, b metav1.Condition) int {
+		return strings.Compare(a.Type, b.Type)
+	})
+
+	return report
+}
+
+// count adds a check, summarized as cond with the impact of its finding, to the summary.
+func (s *ReadinessSummary) count(cond metav1.Condition, impact result.Impact) {
+	switch cond.Status {
+	case metav1.ConditionTrue:
+		s.Passed++
+	case metav1.ConditionUnknown:
+		if cond.Reason == check.ReasonCheckNotExecuted {
+			s.NotExecuted++
+		} else {
+			s.ExecutionErrors++
+		}
+	case metav1.ConditionFalse:
+		switch impact {
+		case result.ImpactProhibited:
+			s.Prohibited++
+		case result.ImpactBlocking:
+			s.Blocking++
+		case result.ImpactAdvisory:
+			s.Advisory++
+		case result.ImpactNone:
+			// Not reached: a False condition always carries a finding
+		}
+	}
+}
+
+// readinessCondition summarizes the result of a check as a single condition whose type
+// is the ID of the check: False with the most severe unwaived finding, Unknown when the
+// check could not be executed, and True otherwise. The impact of the finding is
+// returned along with the condition.
+func readinessCondition(r *result.DiagnosticResult) (metav1.Condition, result.Impact) {
+	cond := metav1.Condition{
+		Type:   r.CheckID(),
+		Status: metav1.ConditionTrue,
+		Reason: check.ReasonRequirementsMet,
+	}
+
+	var finding, failure *result.Condition
+
+	for i := range r.Status.Conditions {
+		c := &r.Status.Conditions[i]
+
+		switch {
+		case check.IsExecutionError(*c):
+			if failure == nil {
+				failure = c
+			}
+		case c.IsWaived() || c.Impact == result.ImpactNone:
+			continue
+		case finding == nil || impactSortPriority(c.Impact) < impactSortPriority(finding.Impact):
+			finding = c
+		}
+	}
+
+	switch {
+	case finding != nil:
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = finding.Reason
+		cond.Message = finding.Message
+
+		return cond, finding.Impact
+	case failure != nil:
+		cond.Status = metav1.ConditionUnknown
+		cond.Reason = failure.Reason
+		cond.Message = failure.Message
+	case len(r.Status.Conditions) > 0:
+		cond.Reason = r.Status.Conditions[0].Reason
+		cond.Message = r.Status.Conditions[0].Message
+	}
+
+	return cond, result.ImpactNone
+}
+
+// ConfigMap returns the report as a ConfigMap in the given namespace.
+func (r *ReadinessReport) ConfigMap(namespace string) (*unstructured.Unstructured, error) {
+	data := make(map[string]any, 3)
+
+	for key, value := range map[string]any{
+		ReportKeySummary:    r.Summary,
+		ReportKeyConditions: r.Conditions,
+		ReportKeyResults:    r.Results,
+	} {
+		encoded, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("encoding %s: %w", key, err)
+		}
+
+		data[key] = string(encoded)
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.ConfigMap.APIVersion(),
+			"kind":       resources.ConfigMap.Kind,
+			"metadata": map[string]any{
+				"name":      ReportConfigMapName,
+				"namespace": namespace,
+				"labels": map[string]any{
+					"app.kubernetes.io/name":       ReportConfigMapName,
+					"app.kubernetes.io/managed-by": client.FieldManager,
+				},
+			},
+			"data": data,
+		},
+	}, nil
+}
+
+// PublishReport writes list to the report ConfigMap of the namespace, creating it or
+// updating the ConfigMap of a previous run.
+func PublishReport(ctx context.Context, c client.Client, namespace string, list *result.DiagnosticResultList) error {
+	previous, err := previousConditions(ctx, c, namespace)
+	if err != nil {
+		return err
+	}
+
+	cm, err := NewReadinessReport(list, previous).ConfigMap(namespace)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.CreateOrUpdate(ctx, resources.ConfigMap, cm); err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+
+	return nil
+}
+
+// previousConditions returns the per-check conditions of the published report, or nil
+// when none was published yet. Conditions that cannot be decoded are discarded.
+func previousConditions(ctx context.Context, c client.Reader, namespace string) ([]metav1.Condition, error) {
+	cm, err := c.GetResource(ctx, resources.ConfigMap, ReportConfigMapName, client.InNamespace(namespace))
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("reading published report: %w", err)
+	}
+
+	if cm == nil {
+		return nil, errors.New("reading published report: permission denied")
+	}
+
+	data, err := jq.Query[string](cm, fmt.Sprintf(".data[%q]", ReportKeyConditions))
+	if err != nil || data == "" {
+		return nil, nil //nolint:nilerr // A missing or corrupted report is replaced rather than failing the run
+	}
+
+	var conditions []metav1.Condition
+	if err := json.Unmarshal([]byte(data), &conditions); err != nil {
+		return nil, nil //nolint:nilerr // A corrupted report is replaced rather than failing the run
+	}
+
+	return conditions, nil
+}
@@ -0,0 +1,171 @@
+package lint_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/testutil"
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+const publishNamespace = "redhat-ods-applications"
+
+func TestNewReadinessReport(t *testing.T) {
+	g := NewWithT(t)
+
+	version := "2.25.0"
+	list := diffList(
+		diffResult("kueue", result.ImpactProhibited),
+		diffResult("codeflare", result.ImpactAdvisory),
+		diffResult("notebook", result.ImpactNone),
+		executionErrorResult().Result,
+		notExecutedResult().Result,
+	)
+	list.ClusterVersion = &version
+
+	report := lint.NewReadinessReport(list, nil)
+
+	g.Expect(report.Summary).To(Equal(lint.ReadinessSummary{
+		ClusterVersion:  "2.25.0",
+		Verdict:         "PROHIBITED",
+		Prohibited:      1,
+		Advisory:        1,
+		Passed:          1,
+		ExecutionErrors: 1,
+		NotExecuted:     1,
+	}))
+
+	g.Expect(report.Conditions).To(HaveExactElements(
+		And(HaveField("Type", "workload.codeflare.impacted-workloads"), HaveField("Status", metav1.ConditionFalse)),
+		And(HaveField("Type", "workload.kueue.data-integrity"), HaveField("Status", metav1.ConditionUnknown),
+			HaveField("Reason", check.ReasonCheckNotExecuted)),
+		And(HaveField("Type", "workload.kueue.impacted-workloads"), HaveField("Status", metav1.ConditionFalse)),
+		And(HaveField("Type", "workload.notebook.impacted-workloads"), HaveField("Status", metav1.ConditionTrue)),
+		And(HaveField("Type", "workload.ray.impacted-workloads"), HaveField("Status", metav1.ConditionUnknown),
+			HaveField("Reason", check.ReasonCheckExecutionFailed)),
+	))
+}
+
+func TestNewReadinessReport_PreviousConditions(t *testing.T) {
+	g := NewWithT(t)
+
+	since := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	previous := []metav1.Condition{
+		{Type: "workload.notebook.impacted-workloads", Status: metav1.ConditionTrue, Reason: "Passed", LastTransitionTime: since},
+		{Type: "workload.kueue.impacted-workloads", Status: metav1.ConditionTrue, Reason: "Passed", LastTransitionTime: since},
+		{Type: "workload.removed.impacted-workloads", Status: metav1.ConditionTrue, Reason: "Passed", LastTransitionTime: since},
+	}
+
+	report := lint.NewReadinessReport(diffList(
+		diffResult("notebook", result.ImpactNone),
+		diffResult("kueue", result.ImpactBlocking),
+	), previous)
+
+	// The unchanged check keeps its transition time, the new finding transitions and
+	// the check without a result is dropped.
+	g.Expect(report.Conditions).To(HaveExactElements(
+		And(HaveField("Type", "workload.kueue.impacted-workloads"), HaveField("Status", metav1.ConditionFalse),
+			WithTransform(func(c metav1.Condition) bool { return c.LastTransitionTime.Equal(&since) }, BeFalse())),
+		And(HaveField("Type", "workload.notebook.impacted-workloads"), HaveField("LastTransitionTime", since)),
+	))
+	g.Expect(report.Summary.Verdict).To(Equal("FAIL"))
+}
+
+func TestNewReadinessReport_SharedGroupKindName(t *testing.T) {
+	g := NewWithT(t)
+
+	// Both checks report workload.kserve.impacted-workloads
+	report := lint.NewReadinessReport(diffList(
+		withCheckID(diffResult("kserve", result.ImpactBlocking, "a"), "workloads.kserve.raw-deployment"),
+		withCheckID(diffResult("kserve", result.ImpactNone), "workloads.kserve.serverless"),
+	), nil)
+
+	g.Expect(report.Conditions).To(HaveExactElements(
+		And(HaveField("Type", "workloads.kserve.raw-deployment"), HaveField("Status", metav1.ConditionFalse)),
+		And(HaveField("Type", "workloads.kserve.serverless"), HaveField("Status", metav1.ConditionTrue)),
+	))
+	g.Expect(report.Results).To(HaveExactElements(
+		HaveField("ID", "workloads.kserve.raw-deployment"),
+		HaveField("ID", "workloads.kserve.serverless"),
+	))
+	g.Expect(report.Summary.Blocking).To(Equal(1))
+	g.Expect(report.Summary.Passed).To(Equal(1))
+}
+
+func TestPublishReport(t *testing.T) {
+	g := NewWithT(t)
+
+	target := testutil.NewTarget(t, testutil.TargetConfig{
+		ListKinds: map[schema.GroupVersionResource]string{
+			resources.ConfigMap.GVR(): resources.ConfigMap.ListKind(),
+		},
+	})
+
+	c, ok := target.Client.(client.Client)
+	g.Expect(ok).To(BeTrue())
+
+	// The first run publishes two checks.
+	g.Expect(lint.PublishReport(t.Context(), c, publishNamespace, diffList(
+		diffResult("kueue", result.ImpactBlocking, "wb-a", "wb-b"),
+		diffResult("notebook", result.ImpactNone),
+	))).To(Succeed())
+
+	g.Expect(publishedConditions(t, c)).To(HaveLen(2))
+
+	// Impacted objects are counted rather than listed.
+	cm, err := c.GetResource(t.Context(), resources.ConfigMap, lint.ReportConfigMapName, client.InNamespace(publishNamespace))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	results, _, _ := unstructured.NestedString(cm.Object, "data", lint.ReportKeyResults)
+	g.Expect(results).ToNot(ContainSubstring("wb-a"))
+
+	var checks []lint.ReadinessCheck
+	g.Expect(json.Unmarshal([]byte(results), &checks)).To(Succeed())
+	g.Expect(checks).To(HaveExactElements(
+		And(HaveField("Kind", "kueue"), HaveField("ImpactedObjects", 2), HaveField("Conditions", HaveLen(1))),
+		And(HaveField("Kind", "notebook"), HaveField("ImpactedObjects", 0)),
+	))
+
+	// The rerun updates the same ConfigMap.
+	g.Expect(lint.PublishReport(t.Context(), c, publishNamespace, diffList(
+		diffResult("notebook", result.ImpactNone),
+	))).To(Succeed())
+
+	list, err := c.List(t.Context(), resources.ConfigMap, client.WithNamespace(publishNamespace))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(list).To(HaveLen(1))
+	g.Expect(list[0].GetName()).To(Equal(lint.ReportConfigMapName))
+
+	g.Expect(publishedConditions(t, c)).To(HaveExactElements(HaveField("Type", "workload.notebook.impacted-workloads")))
+
+	summary, _, _ := unstructured.NestedString(list[0].Object, "data", lint.ReportKeySummary)
+	g.Expect(summary).To(ContainSubstring(`"verdict": "PASS"`))
+}
+
+// publishedConditions returns the per-check conditions of the published report.
+func publishedConditions(t *testing.T, c client.Client) []metav1.Condition {
+	t.Helper()
+
+	g := NewWithT(t)
+
+	cm, err := c.GetResource(t.Context(), resources.ConfigMap, lint.ReportConfigMapName, client.InNamespace(publishNamespace))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	data, _, _ := unstructured.NestedString(cm.Object, "data", lint.ReportKeyConditions)
+
+	var conditions []metav1.Condition
+	g.Expect(json.Unmarshal([]byte(data), &conditions)).To(Succeed())
+
+	return conditions
+}
@@ -0,0 +1,176 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/opendatahub-io/odh-cli/pkg/cmd"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+// Exit codes returned when findings meet the --fail-on policy. The code identifies the
+// highest category found, so that "the cluster is not ready" can be told apart from
+// "some checks could not be executed". Exit code 1 remains a failure to run lint itself.
+const (
+	ExitCodeExecutionError = 2
+	ExitCodeAdvisory       = 3
+	ExitCodeBlocking       = 4
+	ExitCodeProhibited     = 5
+)
+
+// Verdicts of a run, from the most severe findings found.
+const (
+	verdictProhibited = "PROHIBITED"
+	verdictFail       = "FAIL"
+	verdictWarning    = "WARNING"
+	verdictIncomplete = "INCOMPLETE"
+	verdictPass       = "PASS"
+)
+
+// Verify VerdictError implements cmd.ExitCoder at compile time.
+var _ cmd.ExitCoder = (*VerdictError)(nil)
+
+// VerdictError is returned by Run when findings meet the --fail-on policy.
+type VerdictError struct {
+	// Category is the highest finding category found.
+	Category FailOn
+
+	message  string
+	exitCode int
+}
+
+func (e *VerdictError) Error() string {
+	return e.message
+}
+
+// ExitCode implements cmd.ExitCoder.
+func (e *VerdictError) ExitCode() int {
+	return e.exitCode
+}
+
+// Findings summarizes the categories of unwaived findings in a lint run.
+type Findings struct {
+	Prohibited bool
+	Blocking   bool
+	Advisory   bool
+
+	// ExecutionErrors is the number of checks that could not be executed.
+	ExecutionErrors int
+
+	// NotExecuted is the number of checks that did not run because the run timed out or
+	// was canceled first. A run with such checks is incomplete.
+	NotExecuted int
+}
+
+// SummarizeFindings categorizes results for the verdict. Waived conditions are ignored,
+// and conditions reporting that a check could not be executed count as execution errors
+// (or as not executed) rather than advisory findings.
+func SummarizeFindings(results []check.CheckExecution) Findings {
+	var f Findings
+
+	for _, exec := range results {
+		if exec.Result == nil {
+			continue
+		}
+
+		executionFailed := false
+		notExecuted := false
+
+		for _, cond := range exec.Result.Status.Conditions {
+			if check.IsNotExecuted(cond) {
+				notExecuted = true
+
+				continue
+			}
+
+			if cond.IsWaived() {
+				continue
+			}
+
+			if check.IsExecutionError(cond) {
+				executionFailed = true
+
+				continue
+			}
+
+			switch cond.Impact {
+			case result.ImpactProhibited:
+				f.Prohibited = true
+			case result.ImpactBlocking:
+				f.Blocking = true
+			case result.ImpactAdvisory:
+				f.Advisory = true
+			case result.ImpactNone:
+				// No impact on verdict
+			}
+		}
+
+		switch {
+		case notExecuted:
+			f.NotExecuted++
+		case executionFailed:
+			f.ExecutionErrors++
+		}
+	}
+
+	return f
+}
+
+// Evaluate returns a *VerdictError for the highest finding category at or above the
+// fail-on threshold, or nil when the run passes the policy. An incomplete run cannot
+// pass: unless a finding category fails it first, it fails as an execution error at any
+// threshold other than "none".
+func (f Findings) Evaluate(failOn FailOn) error {
+	if failOn == FailOnNone {
+		return nil
+	}
+
+	categories := []struct {
+		category FailOn
+		found    bool
+		exitCode int
+		message  string
+	}{
+		{FailOnProhibited, f.Prohibited, ExitCodeProhibited, "prohibited findings detected: upgrade is not possible"},
+		{FailOnBlocking, f.Blocking, ExitCodeBlocking, "blocking findings detected"},
+		{FailOnAdvisory, f.Advisory, ExitCodeAdvisory, "advisory findings detected"},
+		{FailOnError, f.ExecutionErrors > 0, ExitCodeExecutionError, "some checks could not be executed"},
+	}
+
+	for _, c := range categories {
+		if c.found {
+			return &VerdictError{Category: c.category, message: c.message, exitCode: c.exitCode}
+		}
+
+		// Categories after the threshold do not fail the run.
+		if c.category == failOn {
+			break
+		}
+	}
+
+	if f.NotExecuted > 0 {
+		return &VerdictError{
+			Category: FailOnError,
+			message:  fmt.Sprintf("%d check(s) were not executed: the run is incomplete", f.NotExecuted),
+			exitCode: ExitCodeExecutionError,
+		}
+	}
+
+	return nil
+}
+
+// verdictName returns the verdict of findings, as printed in the Result section.
+func verdictName(f Findings) string {
+	switch {
+	case f.Prohibited:
+		return verdictProhibited
+	case f.Blocking:
+		return verdictFail
+	case f.Advisory:
+		return verdictWarning
+	case f.ExecutionErrors > 0 || f.NotExecuted > 0:
+		return verdictIncomplete
+	default:
+		return verdictPass
+	}
+}
@@ -0,0 +1,127 @@
+package lint_test
+
+import (
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+
+	. "github.com/onsi/gomega"
+)
+
+func executionErrorResult() check.CheckExecution {
+	return check.CheckExecution{
+		Result: &result.DiagnosticResult{
+			Group: "workload",
+			Kind:  "ray",
+			Name:  "impacted-workloads",
+			Status: result.DiagnosticStatus{Conditions: []result.Condition{check.NewCondition(
+				check.ConditionTypeValidated,
+				metav1.ConditionUnknown,
+				check.WithReason(check.ReasonCheckExecutionFailed),
+				check.WithMessage("listing RayClusters: timeout"),
+			)}},
+		},
+	}
+}
+
+func notExecutedResult() check.CheckExecution {
+	return check.CheckExecution{
+		Result: &result.DiagnosticResult{
+			Group: "workload",
+			Kind:  "kueue",
+			Name:  "data-integrity",
+			Status: result.DiagnosticStatus{Conditions: []result.Condition{check.NewCondition(
+				check.ConditionTypeValidated,
+				metav1.ConditionUnknown,
+				check.WithReason(check.ReasonCheckNotExecuted),
+				check.WithMessage("Check was not executed: the run timed out"),
+			)}},
+		},
+	}
+}
+
+func TestSummarizeFindings(t *testing.T) {
+	g := NewWithT(t)
+
+	waived := check.CheckExecution{Result: diffResult("waived", result.ImpactProhibited)}
+	waived.Result.Status.Conditions[0].Waive("accepted", "2026-12-31")
+
+	findings := lint.SummarizeFindings([]check.CheckExecution{
+		{Result: diffResult("blocked", result.ImpactBlocking)},
+		{Result: diffResult("passing", result.ImpactNone)},
+		waived,
+		executionErrorResult(),
+	})
+
+	// The execution error is not counted as an advisory finding, and the waived one is ignored.
+	g.Expect(findings).To(Equal(lint.Findings{Blocking: true, ExecutionErrors: 1}))
+}
+
+func TestSummarizeFindings_NotExecuted(t *testing.T) {
+	g := NewWithT(t)
+
+	findings := lint.SummarizeFindings([]check.CheckExecution{
+		executionErrorResult(),
+		notExecutedResult(),
+		notExecutedResult(),
+	})
+
+	g.Expect(findings).To(Equal(lint.Findings{ExecutionErrors: 1, NotExecuted: 2}))
+}
+
+func TestFindings_Evaluate(t *testing.T) {
+	tests := []struct {
+		name     string
+		findings lint.Findings
+		failOn   lint.FailOn
+		wantCode int
+	}{
+		{"default fails on prohibited", lint.Findings{Prohibited: true, Blocking: true}, lint.FailOnProhibited, lint.ExitCodeProhibited},
+		{"default passes blocking", lint.Findings{Blocking: true}, lint.FailOnProhibited, 0},
+		{"blocking threshold", lint.Findings{Blocking: true, Advisory: true}, lint.FailOnBlocking, lint.ExitCodeBlocking},
+		{"blocking threshold passes advisory", lint.Findings{Advisory: true}, lint.FailOnBlocking, 0},
+		{"advisory threshold", lint.Findings{Advisory: true, ExecutionErrors: 1}, lint.FailOnAdvisory, lint.ExitCodeAdvisory},
+		{"advisory threshold passes execution errors", lint.Findings{ExecutionErrors: 2}, lint.FailOnAdvisory, 0},
+		{"error threshold", lint.Findings{ExecutionErrors: 1}, lint.FailOnError, lint.ExitCodeExecutionError},
+		{"error threshold reports highest category", lint.Findings{Blocking: true, ExecutionErrors: 1}, lint.FailOnError, lint.ExitCodeBlocking},
+		{"none never fails", lint.Findings{Prohibited: true, ExecutionErrors: 1}, lint.FailOnNone, 0},
+		{"no findings", lint.Findings{}, lint.FailOnError, 0},
+		{"incomplete run fails at default threshold", lint.Findings{Blocking: true, NotExecuted: 1}, lint.FailOnProhibited, lint.ExitCodeExecutionError},
+		{"incomplete run reports higher category", lint.Findings{Prohibited: true, NotExecuted: 1}, lint.FailOnProhibited, lint.ExitCodeProhibited},
+		{"none passes incomplete run", lint.Findings{NotExecuted: 1}, lint.FailOnNone, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := tt.findings.Evaluate(tt.failOn)
+			if tt.wantCode == 0 {
+				g.Expect(err).ToNot(HaveOccurred())
+
+				return
+			}
+
+			var verdictErr *lint.VerdictError
+			g.Expect(errors.As(err, &verdictErr)).To(BeTrue())
+			g.Expect(verdictErr.ExitCode()).To(Equal(tt.wantCode))
+		})
+	}
+}
+
+func TestFailOnValidate(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, f := range []lint.FailOn{
+		lint.FailOnProhibited, lint.FailOnBlocking, lint.FailOnAdvisory, lint.FailOnError, lint.FailOnNone,
+	} {
+		g.Expect(f.Validate()).To(Succeed())
+	}
+
+	g.Expect(lint.FailOn("critical").Validate()).To(MatchError(ContainSubstring("invalid fail-on value")))
+}
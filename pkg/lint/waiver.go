@@ -0,0 +1,195 @@
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+)
+
+// Waiver accepts known findings of the checks matching Check, so that they do not affect
+// the verdict until the waiver expires.
+type Waiver struct {
+	// Check selects the waived checks, with the same semantics as --checks.
+	Check string `json:"check"`
+
+	// Objects optionally restricts the waiver to impacted objects matching any of these
+	// namespace/name glob patterns (e.g. "team-a/*"). When empty, the whole check is waived.
+	Objects []string `json:"objects,omitempty"`
+
+	// Reason explains why the findings are accepted.
+	Reason string `json:"reason"`
+
+	// Expires is the last day (YYYY-MM-DD) the waiver applies.
+	Expires string `json:"expires"`
+
+	expiresAt time.Time
+}
+
+// WaiverFile is the document read by --waivers.
+type WaiverFile struct {
+	Waivers []Waiver `json:"waivers"`
+}
+
+// LoadWaivers reads and validates a waivers file.
+func LoadWaivers(filePath string) ([]Waiver, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading waivers file: %w", err)
+	}
+
+	var file WaiverFile
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing waivers file %s: %w", filePath, err)
+	}
+
+	for i := range file.Waivers {
+		if err := file.Waivers[i].validate(); err != nil {
+			return nil, fmt.Errorf("waiver %d: %w", i+1, err)
+		}
+	}
+
+	return file.Waivers, nil
+}
+
+func (w *Waiver) validate() error {
+	if err := ValidateCheckSelector(w.Check); err != nil {
+		return err
+	}
+
+	for _, pattern := range w.Objects {
+		namespace, name, ok := strings.Cut(pattern, "/")
+		if !ok {
+			return fmt.Errorf("invalid object pattern %q: must be namespace/name", pattern)
+		}
+
+		for _, p := range []string{namespace, name} {
+			if _, err := path.Match(p, ""); err != nil {
+				return fmt.Errorf("invalid object pattern %q: %w", pattern, err)
+			}
+		}
+	}
+
+	if strings.TrimSpace(w.Reason) == "" {
+		return errors.New("reason must not be empty")
+	}
+
+	expiresAt, err := time.Parse(time.DateOnly, w.Expires)
+	if err != nil {
+		return fmt.Errorf("invalid expires %q (must be YYYY-MM-DD): %w", w.Expires, err)
+	}
+
+	w.expiresAt = expiresAt
+
+	return nil
+}
+
+// Expired returns true if now is past the waiver's expiry day.
+func (w Waiver) Expired(now time.Time) bool {
+	return !now.UTC().Before(w.expiresAt.AddDate(0, 0, 1))
+}
+
+// matchesObject returns true if the waiver covers the given impacted object.
+func (w Waiver) matchesObject(namespace string, name string) bool {
+	for _, pattern := range w.Objects {
+		nsPattern, namePattern, _ := strings.Cut(pattern, "/")
+
+		nsMatched, _ := path.Match(nsPattern, namespace)
+		nameMatched, _ := path.Match(namePattern, name)
+
+		if nsMatched && nameMatched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ApplyWaivers marks the findings covered by waivers as waived and returns the waivers that
+// have expired, which are not applied.
+//
+// A waiver without object patterns waives every failing condition of the matched checks.
+// A waiver with object patterns annotates the matching impacted objects with
+// result.AnnotationObjectWaived; the check's failing conditions are waived only once all
+// of its impacted objects are. Conditions whose status is Unknown (the check could not be
+// executed) are never waived.
+func ApplyWaivers(results []check.CheckExecution, waivers []Waiver, now time.Time) ([]Waiver, error) {
+	var expired []Waiver
+
+	for _, w := range waivers {
+		if w.Expired(now) {
+			expired = append(expired, w)
+
+			continue
+		}
+
+		for _, exec := range results {
+			if exec.Result == nil || exec.Check == nil {
+				continue
+			}
+
+			matched, err := check.MatchesPattern(exec.Check, w.Check)
+			if err != nil {
+				return nil, fmt.Errorf("matching waiver for %q: %w", w.Check, err)
+			}
+
+			if !matched {
+				continue
+			}
+
+			if len(w.Objects) == 0 || waiveObjects(exec.Result, w) {
+				waiveConditions(exec.Result, w)
+			}
+		}
+	}
+
+	return expired, nil
+}
+
+// waiveObjects annotates the impacted objects matched by the waiver and returns true if
+// every impacted object of the result is now waived.
+func waiveObjects(r *result.DiagnosticResult, w Waiver) bool {
+	allWaived := len(r.ImpactedObjects) > 0
+
+	for i := range r.ImpactedObjects {
+		obj := &r.ImpactedObjects[i]
+
+		if _, ok := obj.Annotations[result.AnnotationObjectWaived]; ok {
+			continue
+		}
+
+		if !w.matchesObject(obj.Namespace, obj.Name) {
+			allWaived = false
+
+			continue
+		}
+
+		if obj.Annotations == nil {
+			obj.Annotations = make(map[string]string)
+		}
+
+		obj.Annotations[result.AnnotationObjectWaived] = w.Reason
+	}
+
+	return allWaived
+}
+
+func waiveConditions(r *result.DiagnosticResult, w Waiver) {
+	for i := range r.Status.Conditions {
+		r.Status.Conditions[i].Waive(w.Reason, w.Expires)
+	}
+}
+
+// waivedObjectReason returns the waiver reason recorded on an impacted object, if any.
+func waivedObjectReason(annotations map[string]string) (string, bool) {
+	reason, ok := annotations[result.AnnotationObjectWaived]
+
+	return reason, ok
+}
@@ -0,0 +1,257 @@
+package lint_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/lint"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check"
+	"github.com/opendatahub-io/odh-cli/pkg/lint/check/result"
+	mocks "github.com/opendatahub-io/odh-cli/pkg/util/test/mocks/check"
+
+	. "github.com/onsi/gomega"
+)
+
+func writeWaivers(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "waivers.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func loadWaivers(t *testing.T, content string) []lint.Waiver {
+	t.Helper()
+
+	waivers, err := lint.LoadWaivers(writeWaivers(t, content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return waivers
+}
+
+// waiverExecution returns a blocking notebook workload result produced by a check with the given ID.
+func waiverExecution(checkID string, objects ...string) check.CheckExecution {
+	mockCheck := mocks.NewMockCheck()
+	mockCheck.On("ID").Return(checkID)
+	mockCheck.On("Group").Return(check.GroupWorkload)
+
+	return check.CheckExecution{
+		Check:  mockCheck,
+		Result: diffResult("notebook", result.ImpactBlocking, objects...),
+	}
+}
+
+func TestLoadWaivers(t *testing.T) {
+	t.Run("parses waivers", func(t *testing.T) {
+		g := NewWithT(t)
+
+		waivers := loadWaivers(t, `
+waivers:
+  - check: workloads.notebook.*
+    objects: ["team-a/*"]
+    reason: migration scheduled
+    expires: "2026-12-31"
+`)
+
+		g.Expect(waivers).To(HaveLen(1))
+		g.Expect(waivers[0].Check).To(Equal("workloads.notebook.*"))
+		g.Expect(waivers[0].Objects).To(Equal([]string{"team-a/*"}))
+		g.Expect(waivers[0].Expired(time.Date(2026, 12, 31, 23, 0, 0, 0, time.UTC))).To(BeFalse())
+		g.Expect(waivers[0].Expired(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC))).To(BeTrue())
+	})
+
+	tests := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{"missing reason", "waivers:\n- check: '*'\n  expires: '2026-12-31'\n", "reason must not be empty"},
+		{"invalid expiry", "waivers:\n- check: '*'\n  reason: r\n  expires: 31/12/2026\n", "must be YYYY-MM-DD"},
+		{"invalid check", "waivers:\n- check: '[x'\n  reason: r\n  expires: '2026-12-31'\n", "invalid check selector pattern"},
+		{"object without namespace", "waivers:\n- check: '*'\n  objects: [nb-1]\n  reason: r\n  expires: '2026-12-31'\n", "must be namespace/name"},
+		{"unknown field", "waivers:\n- check: '*'\n  reason: r\n  expiry: '2026-12-31'\n", "unknown field"},
+	}
+
+	for _, tt := range tests {
+		t.Run("rejects "+tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := lint.LoadWaivers(writeWaivers(t, tt.content))
+			g.Expect(err).To(MatchError(ContainSubstring(tt.err)))
+		})
+	}
+}
+
+func TestApplyWaivers(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("waives every failing condition of matching checks", func(t *testing.T) {
+		g := NewWithT(t)
+
+		waived := waiverExecution("workloads.notebook.impacted-workloads", "nb-1")
+		other := waiverExecution("workloads.ray.impacted-workloads", "rc-1")
+
+		expired, err := lint.ApplyWaivers([]check.CheckExecution{waived, other}, loadWaivers(t, `
+waivers:
+  - check: workloads.notebook.*
+    reason: accepted risk
+    expires: "2026-12-31"
+`), now)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(expired).To(BeEmpty())
+
+		cond := waived.Result.Status.Conditions[0]
+		g.Expect(cond.Impact).To(Equal(result.ImpactAdvisory))
+		g.Expect(cond.Waiver).To(Equal(&result.Waiver{
+			Reason:         "accepted risk",
+			Expires:        "2026-12-31",
+			OriginalImpact: result.ImpactBlocking,
+		}))
+		g.Expect(waived.Result.GetUnwaivedImpact()).To(Equal(result.ImpactNone))
+
+		g.Expect(other.Result.Status.Conditions[0].IsWaived()).To(BeFalse())
+		g.Expect(other.Result.GetUnwaivedImpact()).To(Equal(result.ImpactBlocking))
+	})
+
+	t.Run("waives conditions only once all impacted objects are waived", func(t *testing.T) {
+		g := NewWithT(t)
+
+		exec := waiverExecution("workloads.notebook.impacted-workloads", "nb-1", "nb-2")
+		waivers := loadWaivers(t, `
+waivers:
+  - check: workloads
+    objects: ["ns1/nb-1"]
+    reason: owner notified
+    expires: "2026-12-31"
+`)
+
+		_, err := lint.ApplyWaivers([]check.CheckExecution{exec}, waivers, now)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(exec.Result.ImpactedObjects[0].Annotations).To(
+			HaveKeyWithValue(result.AnnotationObjectWaived, "owner notified"))
+		g.Expect(exec.Result.ImpactedObjects[1].Annotations).ToNot(HaveKey(result.AnnotationObjectWaived))
+		g.Expect(exec.Result.Status.Conditions[0].IsWaived()).To(BeFalse())
+
+		waivers[0].Objects = []string{"ns*/nb-*"}
+
+		_, err = lint.ApplyWaivers([]check.CheckExecution{exec}, waivers, now)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(exec.Result.Status.Conditions[0].IsWaived()).To(BeTrue())
+	})
+
+	t.Run("does not waive conditions that could not be evaluated", func(t *testing.T) {
+		g := NewWithT(t)
+
+		exec := waiverExecution("workloads.notebook.impacted-workloads")
+		exec.Result.Status.Conditions[0].Status = metav1.ConditionUnknown
+
+		_, err := lint.ApplyWaivers([]check.CheckExecution{exec}, loadWaivers(t, `
+waivers:
+  - check: "*"
+    reason: accepted
+    expires: "2026-12-31"
+`), now)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(exec.Result.Status.Conditions[0].IsWaived()).To(BeFalse())
+		g.Expect(exec.Result.GetUnwaivedImpact()).To(Equal(result.ImpactBlocking))
+	})
+
+	t.Run("returns expired waivers without applying them", func(t *testing.T) {
+		g := NewWithT(t)
+
+		exec := waiverExecution("workloads.notebook.impacted-workloads")
+
+		expired, err := lint.ApplyWaivers([]check.CheckExecution{exec}, loadWaivers(t, `
+waivers:
+  - check: "*"
+    reason: temporary
+    expires: "2026-05-31"
+`), now)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(expired).To(HaveLen(1))
+		g.Expect(expired[0].Reason).To(Equal("temporary"))
+		g.Expect(exec.Result.Status.Conditions[0].IsWaived()).To(BeFalse())
+	})
+}
+
+func TestWaiverOutput(t *testing.T) {
+	waivedResults := func(t *testing.T) []check.CheckExecution {
+		t.Helper()
+
+		exec := waiverExecution("workloads.notebook.impacted-workloads", "nb-1")
+
+		_, err := lint.ApplyWaivers([]check.CheckExecution{exec}, loadWaivers(t, `
+waivers:
+  - check: "*"
+    objects: ["*/*"]
+    reason: tracked in JIRA-1
+    expires: "2026-12-31"
+`), time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return []check.CheckExecution{exec}
+	}
+
+	t.Run("table marks waived conditions and objects", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		g.Expect(lint.OutputTable(&buf, waivedResults(t), lint.TableOutputOptions{})).To(Succeed())
+
+		output := buf.String()
+		g.Expect(output).To(ContainSubstring("(waived: tracked in JIRA-1)"))
+		g.Expect(output).To(ContainSubstring("Warnings: 1 | Failed: 0 | Prohibited: 0 | Waived: 1"))
+		g.Expect(output).To(ContainSubstring("Waived Objects:"))
+		g.Expect(output).To(ContainSubstring("Notebook/ns1/nb-1: tracked in JIRA-1"))
+	})
+
+	t.Run("json records the waiver", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		g.Expect(lint.OutputJSON(&buf, waivedResults(t), nil, nil, nil)).To(Succeed())
+		g.Expect(buf.String()).To(ContainSubstring(`"originalImpact": "blocking"`))
+		g.Expect(buf.String()).To(ContainSubstring(`"result.opendatahub.io/waived": "tracked in JIRA-1"`))
+	})
+
+	t.Run("junit reports waived checks as skipped", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		g.Expect(lint.OutputJUnit(&buf, waivedResults(t), nil, nil, nil)).To(Succeed())
+		g.Expect(buf.String()).To(ContainSubstring(`<skipped message="waived: tracked in JIRA-1">`))
+		g.Expect(buf.String()).To(ContainSubstring(`failures="0"`))
+	})
+
+	t.Run("sarif suppresses waived results", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		g.Expect(lint.OutputSARIF(&buf, waivedResults(t), nil, nil, nil)).To(Succeed())
+		g.Expect(buf.String()).To(ContainSubstring(`"justification": "tracked in JIRA-1"`))
+		g.Expect(buf.String()).To(ContainSubstring(`"waivedObjects"`))
+	})
+
+	t.Run("html shows the waiver and ignores it in the verdict", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		g.Expect(lint.OutputHTML(&buf, waivedResults(t), lint.HTMLOutputOptions{})).To(Succeed())
+		g.Expect(buf.String()).To(ContainSubstring("Waived until 2026-12-31 (was blocking): tracked in JIRA-1"))
+		g.Expect(buf.String()).To(ContainSubstring("nb-1 (Notebook) [waived: tracked in JIRA-1]"))
+		g.Expect(buf.String()).To(ContainSubstring("<strong>PASS</strong>"))
+	})
+}
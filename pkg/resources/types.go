@@ -199,6 +199,13 @@ var (
 		Resource: "persistentvolumeclaims",
 	}
 
+	ServiceAccount = ResourceType{
+		Group:    "",
+		Version:  "v1",
+		Kind:     "ServiceAccount",
+		Resource: "serviceaccounts",
+	}
+
 	// Notebook is the Kubeflow Notebook resource.
 	Notebook = ResourceType{
 		Group:    "kubeflow.org",
@@ -0,0 +1,164 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+)
+
+// olmReader serves OLM Subscriptions and ClusterServiceVersions from the snapshot.
+type olmReader struct {
+	reader *Reader
+}
+
+// Available reports whether the snapshot contains any OLM Subscriptions or CSVs.
+func (o *olmReader) Available() bool {
+	return len(o.reader.objects[resources.Subscription.GVK().GroupKind()]) > 0 ||
+		len(o.reader.objects[resources.ClusterServiceVersion.GVK().GroupKind()]) > 0
+}
+
+func (o *olmReader) Subscriptions(namespace string) client.SubscriptionReader {
+	return &subscriptionReader{reader: o.reader, namespace: namespace}
+}
+
+func (o *olmReader) ClusterServiceVersions(namespace string) client.CSVReader {
+	return &csvReader{reader: o.reader, namespace: namespace}
+}
+
+type subscriptionReader struct {
+	reader    *Reader
+	namespace string
+}
+
+func (s *subscriptionReader) List(
+	_ context.Context,
+	opts metav1.ListOptions,
+) (*operatorsv1alpha1.SubscriptionList, error) {
+	items, err := listOLM(s.reader, resources.Subscription, s.namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &operatorsv1alpha1.SubscriptionList{
+		Items: make([]operatorsv1alpha1.Subscription, len(items)),
+	}
+
+	for i, item := range items {
+		if err := fromUnstructured(item, &list.Items[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return list, nil
+}
+
+func (s *subscriptionReader) Get(
+	_ context.Context,
+	name string,
+	_ metav1.GetOptions,
+) (*operatorsv1alpha1.Subscription, error) {
+	item, err := getOLM(s.reader, resources.Subscription, s.namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &operatorsv1alpha1.Subscription{}
+	if err := fromUnstructured(item, sub); err != nil {
+		return nil, err
+	}
+
+	return sub, nil
+}
+
+type csvReader struct {
+	reader    *Reader
+	namespace string
+}
+
+func (c *csvReader) List(
+	_ context.Context,
+	opts metav1.ListOptions,
+) (*operatorsv1alpha1.ClusterServiceVersionList, error) {
+	items, err := listOLM(c.reader, resources.ClusterServiceVersion, c.namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &operatorsv1alpha1.ClusterServiceVersionList{
+		Items: make([]operatorsv1alpha1.ClusterServiceVersion, len(items)),
+	}
+
+	for i, item := range items {
+		if err := fromUnstructured(item, &list.Items[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return list, nil
+}
+
+func (c *csvReader) Get(
+	_ context.Context,
+	name string,
+	_ metav1.GetOptions,
+) (*operatorsv1alpha1.ClusterServiceVersion, error) {
+	item, err := getOLM(c.reader, resources.ClusterServiceVersion, c.namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	csv := &operatorsv1alpha1.ClusterServiceVersion{}
+	if err := fromUnstructured(item, csv); err != nil {
+		return nil, err
+	}
+
+	return csv, nil
+}
+
+func listOLM(
+	r *Reader,
+	resourceType resources.ResourceType,
+	namespace string,
+	opts metav1.ListOptions,
+) ([]*unstructured.Unstructured, error) {
+	return r.list(
+		resourceType.GVK().GroupKind(),
+		client.WithNamespace(namespace),
+		client.WithLabelSelector(opts.LabelSelector),
+		client.WithFieldSelector(opts.FieldSelector),
+		client.WithLimit(opts.Limit),
+	)
+}
+
+// getOLM mirrors the OLM clientset, which returns a bare NotFound error for missing objects.
+func getOLM(
+	r *Reader,
+	resourceType resources.ResourceType,
+	namespace string,
+	name string,
+) (*unstructured.Unstructured, error) {
+	for _, obj := range r.objects[resourceType.GVK().GroupKind()] {
+		if obj.GetName() == name && obj.GetNamespace() == namespace {
+			return obj, nil
+		}
+	}
+
+	return nil, apierrors.NewNotFound(resourceType.GVR().GroupResource(), name)
+}
+
+func fromUnstructured(obj *unstructured.Unstructured, into any) error {
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, into); err != nil {
+		return fmt.Errorf("converting %s %s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,497 @@
+// Package snapshot provides a file-backed client.Reader that serves Kubernetes objects
+// captured from a cluster, so lint checks can run without cluster access.
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+const decoderBufferSize = 4096
+
+// Verify Reader implements client.Reader interface at compile time.
+var _ client.Reader = (*Reader)(nil)
+
+// Reader is a read-only client.Reader backed by YAML files on disk.
+//
+// It accepts a must-gather dump, the layout written by backup.WriteResourceToFile, and
+// archives written by Write: every .yaml, .yml and .json file is decoded, multi-document
+// files and List kinds are expanded, and documents that are not Kubernetes objects are ignored.
+//
+// Objects are matched by group and kind only, mirroring the API server serving every
+// version of a resource: a query for v1 DataSciencePipelinesApplications returns objects
+// captured as v1alpha1. Resource types with no captured objects return an empty list.
+type Reader struct {
+	dir string
+
+	// objects holds captured objects keyed by group/kind, sorted by namespace and name.
+	objects map[schema.GroupKind][]*unstructured.Unstructured
+
+	// kinds maps a group/resource (plural) to its kind, used to resolve GVR-based lookups.
+	kinds map[schema.GroupResource]string
+
+	olm *olmReader
+}
+
+// NewReader loads every Kubernetes object found below path, which is either a
+// directory or a tar archive (.tar, .tar.gz or .tgz) such as the one written by Write.
+func NewReader(path string) (*Reader, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading snapshot: %w", err)
+	}
+
+	archive := !info.IsDir()
+	if archive && !IsArchive(path) {
+		return nil, fmt.Errorf("snapshot path %s is neither a directory nor a tar archive", path)
+	}
+
+	r := &Reader{
+		dir:     path,
+		objects: make(map[schema.GroupKind][]*unstructured.Unstructured),
+		kinds:   make(map[schema.GroupResource]string),
+	}
+
+	if err := r.load(archive); err != nil {
+		return nil, err
+	}
+
+	r.olm = &olmReader{reader: r}
+
+	return r, nil
+}
+
+// Dir returns the snapshot root directory or archive path.
+func (r *Reader) Dir() string {
+	return r.dir
+}
+
+// List lists all captured instances of a resource type.
+func (r *Reader) List(
+	_ context.Context,
+	resourceType resources.ResourceType,
+	opts ...client.ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	return r.list(resourceType.GVK().GroupKind(), opts...)
+}
+
+// ListMetadata lists all captured instances of a resource type returning only metadata.
+func (r *Reader) ListMetadata(
+	_ context.Context,
+	resourceType resources.ResourceType,
+	opts ...client.ListResourcesOption,
+) ([]*metav1.PartialObjectMetadata, error) {
+	items, err := r.list(resourceType.GVK().GroupKind(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*metav1.PartialObjectMetadata, 0, len(items))
+	for _, item := range items {
+		result = append(result, toPartialObjectMetadata(resourceType, item))
+	}
+
+	return result, nil
+}
+
+// ListResources lists all captured instances of a resource by GVR.
+func (r *Reader) ListResources(
+	_ context.Context,
+	gvr schema.GroupVersionResource,
+	opts ...client.ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	return r.list(r.groupKindFor(gvr), opts...)
+}
+
+// Get retrieves a single captured resource by GVR and name.
+// Returns a NotFound error when the object is not part of the snapshot.
+func (r *Reader) Get(
+	_ context.Context,
+	gvr schema.GroupVersionResource,
+	name string,
+	opts ...client.GetOption,
+) (*unstructured.Unstructured, error) {
+	return r.get(r.groupKindFor(gvr), gvr.GroupResource(), name, opts...)
+}
+
+// GetResource retrieves a single captured resource by ResourceType and name.
+func (r *Reader) GetResource(
+	_ context.Context,
+	resourceType resources.ResourceType,
+	name string,
+	opts ...client.GetOption,
+) (*unstructured.Unstructured, error) {
+	return r.get(resourceType.GVK().GroupKind(), resourceType.GVR().GroupResource(), name, opts...)
+}
+
+// GetResourceMetadata retrieves only the metadata of a single captured resource.
+func (r *Reader) GetResourceMetadata(
+	_ context.Context,
+	resourceType resources.ResourceType,
+	name string,
+	opts ...client.GetOption,
+) (*metav1.PartialObjectMetadata, error) {
+	obj, err := r.get(resourceType.GVK().GroupKind(), resourceType.GVR().GroupResource(), name, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPartialObjectMetadata(resourceType, obj), nil
+}
+
+// OLM returns a read-only accessor for the Subscriptions and CSVs in the snapshot.
+func (r *Reader) OLM() client.OLMReader {
+	return r.olm
+}
+
+func (r *Reader) list(gk schema.GroupKind, opts ...client.ListResourcesOption) ([]*unstructured.Unstructured, error) {
+	cfg := &client.ListResourcesConfig{}
+	util.ApplyOptions(cfg, opts...)
+
+	sel, err := newSelector(cfg.Namespace, cfg.LabelSelector, cfg.FieldSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*unstructured.Unstructured, 0)
+
+	for _, obj := range r.objects[gk] {
+		if !sel.matches(obj) {
+			continue
+		}
+
+		result = append(result, obj.DeepCopy())
+
+		if cfg.Limit > 0 && int64(len(result)) >= cfg.Limit {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Reader) get(
+	gk schema.GroupKind,
+	gr schema.GroupResource,
+	name string,
+	opts ...client.GetOption,
+) (*unstructured.Unstructured, error) {
+	cfg := &client.GetConfig{}
+	util.ApplyOptions(cfg, opts...)
+
+	for _, obj := range r.objects[gk] {
+		if obj.GetName() == name && obj.GetNamespace() == cfg.Namespace {
+			return obj.DeepCopy(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("getting resource: %w", apierrors.NewNotFound(gr, name))
+}
+
+// groupKindFor resolves the kind of a GVR using CRDs captured in the snapshot,
+// falling back to the conventional lowercase plural of each captured kind.
+func (r *Reader) groupKindFor(gvr schema.GroupVersionResource) schema.GroupKind {
+	if kind, ok := r.kinds[gvr.GroupResource()]; ok {
+		return schema.GroupKind{Group: gvr.Group, Kind: kind}
+	}
+
+	// Unknown resource: return a kind that matches nothing.
+	return schema.GroupKind{Group: gvr.Group, Kind: gvr.Resource}
+}
+
+// load reads every manifest in the snapshot directory or archive and indexes the objects found.
+func (r *Reader) load(archive bool) error {
+	seen := make(map[string]struct{})
+
+	addAll := func(name string, rd io.Reader) error {
+		objs, err := decode(rd)
+		if err != nil {
+			return fmt.Errorf("decoding %s: %w", name, err)
+		}
+
+		for _, obj := range objs {
+			key := objectKey(obj)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+			r.add(obj)
+		}
+
+		return nil
+	}
+
+	var err error
+	if archive {
+		err = walkArchive(r.dir, addAll)
+	} else {
+		err = walkDir(r.dir, addAll)
+	}
+
+	if err != nil {
+		return fmt.Errorf("loading snapshot from %s: %w", r.dir, err)
+	}
+
+	for gk := range r.objects {
+		slices.SortFunc(r.objects[gk], func(a, b *unstructured.Unstructured) int {
+			if c := strings.Compare(a.GetNamespace(), b.GetNamespace()); c != 0 {
+				return c
+			}
+
+			return strings.Compare(a.GetName(), b.GetName())
+		})
+	}
+
+	r.indexCRDs()
+
+	return nil
+}
+
+func (r *Reader) add(obj *unstructured.Unstructured) {
+	gvk := obj.GroupVersionKind()
+	gk := gvk.GroupKind()
+
+	r.objects[gk] = append(r.objects[gk], obj)
+
+	plural, _ := meta.UnsafeGuessKindToResource(gvk)
+	if _, ok := r.kinds[plural.GroupResource()]; !ok {
+		r.kinds[plural.GroupResource()] = gvk.Kind
+	}
+}
+
+// indexCRDs registers the plural names declared by captured CRDs, which take
+// precedence over guessed plurals for irregular kinds.
+func (r *Reader) indexCRDs() {
+	for _, crd := range r.objects[resources.CustomResourceDefinition.GVK().GroupKind()] {
+		group, err := jq.Query[string](crd, ".spec.group")
+		if err != nil {
+			continue
+		}
+
+		plural, err := jq.Query[string](crd, ".spec.names.plural")
+		if err != nil {
+			continue
+		}
+
+		kind, err := jq.Query[string](crd, ".spec.names.kind")
+		if err != nil {
+			continue
+		}
+
+		r.kinds[schema.GroupResource{Group: group, Resource: plural}] = kind
+	}
+}
+
+func isManifestFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+// walkDir calls fn for every manifest file below dir.
+func walkDir(dir string, fn func(name string, rd io.Reader) error) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || !isManifestFile(path) {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("opening file: %w", err)
+		}
+
+		defer func() { _ = f.Close() }()
+
+		return fn(path, f)
+	})
+}
+
+// walkArchive calls fn for every manifest file in a tar archive, optionally gzip-compressed.
+func walkArchive(path string, fn func(name string, rd io.Reader) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening archive: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	var rd io.Reader = f
+
+	if isGzipArchive(path) {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("reading gzip stream: %w", err)
+		}
+
+		defer func() { _ = gz.Close() }()
+
+		rd = gz
+	}
+
+	tr := tar.NewReader(rd)
+
+	for {
+		hdr, err := tr.Next()
+
+		switch {
+		case errors.Is(err, io.EOF):
+			return nil
+		case err != nil:
+			return fmt.Errorf("reading archive: %w", err)
+		}
+
+		if hdr.Typeflag != tar.TypeReg || !isManifestFile(hdr.Name) {
+			continue
+		}
+
+		if err := fn(hdr.Name, tr); err != nil {
+			return err
+		}
+	}
+}
+
+// decode decodes every document in a YAML or JSON stream, expanding List kinds.
+// Documents without apiVersion and kind are skipped.
+func decode(rd io.Reader) ([]*unstructured.Unstructured, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(rd, decoderBufferSize)
+
+	var result []*unstructured.Unstructured
+
+	for {
+		var doc any
+
+		err := decoder.Decode(&doc)
+
+		switch {
+		case errors.Is(err, io.EOF):
+			return result, nil
+		case err != nil:
+			return nil, fmt.Errorf("decoding document: %w", err)
+		}
+
+		content, ok := doc.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{Object: content}
+		if obj.GetAPIVersion() == "" || obj.GetKind() == "" {
+			continue
+		}
+
+		if !obj.IsList() {
+			result = append(result, obj)
+
+			continue
+		}
+
+		err = obj.EachListItem(func(item runtime.Object) error {
+			if u, ok := item.(*unstructured.Unstructured); ok && u.GetKind() != "" {
+				result = append(result, u)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("expanding %s: %w", obj.GetKind(), err)
+		}
+	}
+}
+
+func objectKey(obj *unstructured.Unstructured) string {
+	gk := obj.GroupVersionKind().GroupKind()
+
+	return gk.String() + "/" + obj.GetNamespace() + "/" + obj.GetName()
+}
+
+func toPartialObjectMetadata(
+	resourceType resources.ResourceType,
+	obj *unstructured.Unstructured,
+) *metav1.PartialObjectMetadata {
+	return &metav1.PartialObjectMetadata{
+		TypeMeta: resourceType.TypeMeta(),
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              obj.GetName(),
+			Namespace:         obj.GetNamespace(),
+			UID:               obj.GetUID(),
+			ResourceVersion:   obj.GetResourceVersion(),
+			Generation:        obj.GetGeneration(),
+			CreationTimestamp: obj.GetCreationTimestamp(),
+			DeletionTimestamp: obj.GetDeletionTimestamp(),
+			Labels:            obj.GetLabels(),
+			Annotations:       obj.GetAnnotations(),
+			OwnerReferences:   obj.GetOwnerReferences(),
+			Finalizers:        obj.GetFinalizers(),
+		},
+	}
+}
+
+// selector filters objects by namespace, label selector and field selector.
+// Only metadata.name and metadata.namespace are supported as field selector keys,
+// which covers the selectors the API server supports for every resource.
+type selector struct {
+	namespace string
+	labels    labels.Selector
+	fields    fields.Selector
+}
+
+func newSelector(namespace string, labelSelector string, fieldSelector string) (*selector, error) {
+	ls, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("parsing label selector %q: %w", labelSelector, err)
+	}
+
+	fs, err := fields.ParseSelector(fieldSelector)
+	if err != nil {
+		return nil, fmt.Errorf("parsing field selector %q: %w", fieldSelector, err)
+	}
+
+	return &selector{namespace: namespace, labels: ls, fields: fs}, nil
+}
+
+func (s *selector) matches(obj *unstructured.Unstructured) bool {
+	if s.namespace != "" && obj.GetNamespace() != s.namespace {
+		return false
+	}
+
+	if !s.labels.Matches(labels.Set(obj.GetLabels())) {
+		return false
+	}
+
+	return s.fields.Matches(fields.Set{
+		"metadata.name":      obj.GetName(),
+		"metadata.namespace": obj.GetNamespace(),
+	})
+}
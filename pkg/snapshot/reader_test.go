@@ -0,0 +1,274 @@
+package snapshot_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/snapshot"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/version"
+
+	. "github.com/onsi/gomega"
+)
+
+const dscYAML = `apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+metadata:
+  name: default-dsc
+status:
+  release:
+    version: 2.25.1
+`
+
+const dsciYAML = `apiVersion: dscinitialization.opendatahub.io/v1
+kind: DSCInitialization
+metadata:
+  name: default-dsci
+spec:
+  applicationsNamespace: redhat-ods-applications
+`
+
+// Must-gather style List containing two notebooks in different namespaces.
+const notebookListYAML = `apiVersion: v1
+kind: List
+items:
+- apiVersion: kubeflow.org/v1
+  kind: Notebook
+  metadata:
+    name: nb-a
+    namespace: team-a
+    labels:
+      app: jupyter
+- apiVersion: kubeflow.org/v1
+  kind: Notebook
+  metadata:
+    name: nb-b
+    namespace: team-b
+`
+
+// Backup style single-object file duplicating an item of the List above.
+const notebookYAML = `apiVersion: kubeflow.org/v1
+kind: Notebook
+metadata:
+  name: nb-a
+  namespace: team-a
+  labels:
+    app: jupyter
+`
+
+const dspaYAML = `apiVersion: datasciencepipelinesapplications.opendatahub.io/v1alpha1
+kind: DataSciencePipelinesApplication
+metadata:
+  name: dspa
+  namespace: team-a
+`
+
+const csvYAML = `apiVersion: operators.coreos.com/v1alpha1
+kind: ClusterServiceVersion
+metadata:
+  name: rhods-operator.2.25.1
+  namespace: redhat-ods-operator
+  labels:
+    operators.coreos.com/rhods-operator.redhat-ods-operator: ""
+spec:
+  version: 2.25.1
+---
+apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: rhods-operator
+  namespace: redhat-ods-operator
+spec:
+  channel: stable-2.25
+  name: rhods-operator
+`
+
+const nonKubernetesYAML = `timestamp: 2025-01-01
+images:
+- quay.io/example/must-gather
+`
+
+func writeSnapshot(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestReader_MustGatherAndBackupLayouts(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	dir := writeSnapshot(t, map[string]string{
+		"cluster-scoped-resources/datasciencecluster.opendatahub.io/datascienceclusters/default-dsc.yaml":   dscYAML,
+		"cluster-scoped-resources/dscinitialization.opendatahub.io/dscinitializations/default-dsci.yaml":    dsciYAML,
+		"namespaces/all/kubeflow.org/notebooks.yaml":                                                        notebookListYAML,
+		"team-a/notebooks.kubeflow.org-nb-a.yaml":                                                           notebookYAML,
+		"team-a/datasciencepipelinesapplications.datasciencepipelinesapplications.opendatahub.io-dspa.yaml": dspaYAML,
+		"timestamp.yaml":    nonKubernetesYAML,
+		"event-filter.html": "<html></html>",
+	})
+
+	r, err := snapshot.NewReader(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	t.Run("lists expanded and deduplicated objects", func(t *testing.T) {
+		g := NewWithT(t)
+
+		items, err := r.List(ctx, resources.Notebook)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(items).To(HaveLen(2))
+		g.Expect(items[0].GetName()).To(Equal("nb-a"))
+		g.Expect(items[1].GetName()).To(Equal("nb-b"))
+	})
+
+	t.Run("filters by namespace and label selector", func(t *testing.T) {
+		g := NewWithT(t)
+
+		items, err := r.List(ctx, resources.Notebook, client.WithNamespace("team-b"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(items).To(HaveLen(1))
+		g.Expect(items[0].GetName()).To(Equal("nb-b"))
+
+		meta, err := r.ListMetadata(ctx, resources.Notebook, client.WithLabelSelector("app=jupyter"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(meta).To(HaveLen(1))
+		g.Expect(meta[0].Name).To(Equal("nb-a"))
+		g.Expect(meta[0].Kind).To(Equal(resources.Notebook.Kind))
+	})
+
+	t.Run("serves other versions of the same resource", func(t *testing.T) {
+		g := NewWithT(t)
+
+		items, err := r.ListResources(ctx, resources.DataSciencePipelinesApplicationV1.GVR())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(items).To(HaveLen(1))
+		g.Expect(items[0].GetName()).To(Equal("dspa"))
+	})
+
+	t.Run("returns empty list for resource types not in snapshot", func(t *testing.T) {
+		g := NewWithT(t)
+
+		items, err := r.List(ctx, resources.RayCluster)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(items).To(BeEmpty())
+	})
+
+	t.Run("gets objects by GVR and name", func(t *testing.T) {
+		g := NewWithT(t)
+
+		obj, err := r.Get(ctx, resources.Notebook.GVR(), "nb-a", client.InNamespace("team-a"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(obj.GetLabels()).To(HaveKeyWithValue("app", "jupyter"))
+
+		_, err = r.Get(ctx, resources.Notebook.GVR(), "nb-a", client.InNamespace("team-b"))
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	t.Run("gets metadata by resource type", func(t *testing.T) {
+		g := NewWithT(t)
+
+		meta, err := r.GetResourceMetadata(ctx, resources.DataScienceCluster, "default-dsc")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(meta.Name).To(Equal("default-dsc"))
+		g.Expect(meta.APIVersion).To(Equal(resources.DataScienceCluster.APIVersion()))
+	})
+
+	t.Run("detects version from DataScienceCluster", func(t *testing.T) {
+		g := NewWithT(t)
+
+		v, err := version.Detect(ctx, r)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(v.String()).To(Equal("2.25.1"))
+	})
+
+	t.Run("resolves applications namespace", func(t *testing.T) {
+		g := NewWithT(t)
+
+		ns, err := client.GetApplicationsNamespace(ctx, r)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ns).To(Equal("redhat-ods-applications"))
+	})
+
+	t.Run("OLM is unavailable without OLM objects", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(r.OLM().Available()).To(BeFalse())
+	})
+}
+
+func TestReader_OLM(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	dir := writeSnapshot(t, map[string]string{
+		"namespaces/redhat-ods-operator/operators.coreos.com/olm.yaml": csvYAML,
+	})
+
+	r, err := snapshot.NewReader(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(r.OLM().Available()).To(BeTrue())
+
+	t.Run("detects version from CSV", func(t *testing.T) {
+		g := NewWithT(t)
+
+		v, err := version.Detect(ctx, r)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(v.String()).To(Equal("2.25.1"))
+	})
+
+	t.Run("lists subscriptions", func(t *testing.T) {
+		g := NewWithT(t)
+
+		subs, err := r.OLM().Subscriptions("").List(ctx, metav1.ListOptions{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(subs.Items).To(HaveLen(1))
+		g.Expect(subs.Items[0].Spec.Channel).To(Equal("stable-2.25"))
+	})
+
+	t.Run("gets CSV by name", func(t *testing.T) {
+		g := NewWithT(t)
+
+		csv, err := r.OLM().ClusterServiceVersions("redhat-ods-operator").Get(ctx, "rhods-operator.2.25.1", metav1.GetOptions{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(csv.Spec.Version.String()).To(Equal("2.25.1"))
+
+		_, err = r.OLM().ClusterServiceVersions("other").Get(ctx, "rhods-operator.2.25.1", metav1.GetOptions{})
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+}
+
+func TestNewReader_Errors(t *testing.T) {
+	t.Run("missing directory", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := snapshot.NewReader(filepath.Join(t.TempDir(), "missing"))
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("malformed YAML", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := writeSnapshot(t, map[string]string{"broken.yaml": "kind: [unterminated"})
+
+		_, err := snapshot.NewReader(dir)
+		g.Expect(err).To(MatchError(ContainSubstring("broken.yaml")))
+	})
+}
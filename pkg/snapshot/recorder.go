@@ -0,0 +1,363 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+)
+
+// Verify Recorder implements client.Reader interface at compile time.
+var _ client.Reader = (*Recorder)(nil)
+
+// Recorder is a client.Reader decorator that remembers every object returned by the
+// wrapped reader, including OLM Subscriptions and CSVs, so they can be written with Write
+// and replayed later through Reader.
+//
+// Objects read only through metadata calls are recorded with their metadata alone;
+// a later full read of the same object replaces that entry. Secret values are redacted
+// (keys are kept), since snapshots are meant to be shared with support.
+// Recorder is safe for concurrent use.
+type Recorder struct {
+	reader client.Reader
+
+	mu      sync.Mutex
+	objects map[string]recordedObject
+}
+
+type recordedObject struct {
+	Object
+
+	metadataOnly bool
+}
+
+// NewRecorder wraps reader in a Recorder.
+func NewRecorder(reader client.Reader) *Recorder {
+	return &Recorder{
+		reader:  reader,
+		objects: make(map[string]recordedObject),
+	}
+}
+
+// Objects returns a copy of every object recorded so far.
+func (r *Recorder) Objects() []Object {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := make([]Object, 0, len(r.objects))
+	for _, rec := range r.objects {
+		result = append(result, Object{Resource: rec.Resource, Object: rec.Object.Object.DeepCopy()})
+	}
+
+	return result
+}
+
+func (r *Recorder) List(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	opts ...client.ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	items, err := r.reader.List(ctx, resourceType, opts...)
+	if err != nil {
+		//nolint:wrapcheck // Decorator passes through errors from the wrapped reader unchanged
+		return nil, err
+	}
+
+	r.record(resourceType.GVR().GroupResource(), items...)
+
+	return items, nil
+}
+
+func (r *Recorder) ListMetadata(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	opts ...client.ListResourcesOption,
+) ([]*metav1.PartialObjectMetadata, error) {
+	items, err := r.reader.ListMetadata(ctx, resourceType, opts...)
+	if err != nil {
+		//nolint:wrapcheck // Decorator passes through errors from the wrapped reader unchanged
+		return nil, err
+	}
+
+	r.recordMetadata(resourceType, items...)
+
+	return items, nil
+}
+
+func (r *Recorder) ListResources(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	opts ...client.ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	items, err := r.reader.ListResources(ctx, gvr, opts...)
+	if err != nil {
+		//nolint:wrapcheck // Decorator passes through errors from the wrapped reader unchanged
+		return nil, err
+	}
+
+	r.record(gvr.GroupResource(), items...)
+
+	return items, nil
+}
+
+func (r *Recorder) Get(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	name string,
+	opts ...client.GetOption,
+) (*unstructured.Unstructured, error) {
+	obj, err := r.reader.Get(ctx, gvr, name, opts...)
+	if err != nil {
+		//nolint:wrapcheck // Decorator passes through errors from the wrapped reader unchanged
+		return nil, err
+	}
+
+	r.record(gvr.GroupResource(), obj)
+
+	return obj, nil
+}
+
+func (r *Recorder) GetResource(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	name string,
+	opts ...client.GetOption,
+) (*unstructured.Unstructured, error) {
+	obj, err := r.reader.GetResource(ctx, resourceType, name, opts...)
+	if err != nil {
+		//nolint:wrapcheck // Decorator passes through errors from the wrapped reader unchanged
+		return nil, err
+	}
+
+	r.record(resourceType.GVR().GroupResource(), obj)
+
+	return obj, nil
+}
+
+func (r *Recorder) GetResourceMetadata(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	name string,
+	opts ...client.GetOption,
+) (*metav1.PartialObjectMetadata, error) {
+	obj, err := r.reader.GetResourceMetadata(ctx, resourceType, name, opts...)
+	if err != nil {
+		//nolint:wrapcheck // Decorator passes through errors from the wrapped reader unchanged
+		return nil, err
+	}
+
+	r.recordMetadata(resourceType, obj)
+
+	return obj, nil
+}
+
+func (r *Recorder) OLM() client.OLMReader {
+	return &recordingOLMReader{recorder: r, reader: r.reader.OLM()}
+}
+
+// record stores full objects, replacing metadata-only entries for the same object.
+// Nil objects (returned by the live client on permission errors) are ignored, and so
+// are objects that cannot be sanitized, so that Secret values are never recorded.
+func (r *Recorder) record(gr schema.GroupResource, objs ...*unstructured.Unstructured) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+
+		sanitized, err := sanitize(obj)
+		if err != nil {
+			continue
+		}
+
+		r.objects[objectKey(obj)] = recordedObject{
+			Object: Object{Resource: gr, Object: sanitized},
+		}
+	}
+}
+
+func (r *Recorder) recordMetadata(resourceType resources.ResourceType, objs ...*metav1.PartialObjectMetadata) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+
+		u, err := metadataToUnstructured(resourceType, obj)
+		if err != nil {
+			continue
+		}
+
+		key := objectKey(u)
+		if existing, ok := r.objects[key]; ok && !existing.metadataOnly {
+			continue
+		}
+
+		r.objects[key] = recordedObject{
+			Object:       Object{Resource: resourceType.GVR().GroupResource(), Object: u},
+			metadataOnly: true,
+		}
+	}
+}
+
+// recordTyped converts a typed OLM object to unstructured and records it.
+// Typed list items carry no TypeMeta, so it is set from resourceType.
+func (r *Recorder) recordTyped(resourceType resources.ResourceType, obj runtime.Object) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return
+	}
+
+	u := &unstructured.Unstructured{Object: content}
+	u.SetAPIVersion(resourceType.APIVersion())
+	u.SetKind(resourceType.Kind)
+
+	r.record(resourceType.GVR().GroupResource(), u)
+}
+
+// sanitizeSecretExpression drops the stringData of a Secret and blanks its data values.
+const sanitizeSecretExpression = `del(.stringData) | if .data | type == "object" then .data |= map_values("") else . end`
+
+// sanitize returns a copy of obj without managed fields and with Secret values blanked.
+func sanitize(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	out := obj.DeepCopy()
+	out.SetManagedFields(nil)
+
+	if out.GroupVersionKind().GroupKind() != resources.Secret.GVK().GroupKind() {
+		return out, nil
+	}
+
+	if err := jq.Transform(out, sanitizeSecretExpression); err != nil {
+		return nil, fmt.Errorf("sanitizing Secret: %w", err)
+	}
+
+	return out, nil
+}
+
+func metadataToUnstructured(
+	resourceType resources.ResourceType,
+	obj *metav1.PartialObjectMetadata,
+) (*unstructured.Unstructured, error) {
+	meta := obj.ObjectMeta.DeepCopy()
+	meta.ManagedFields = nil
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(meta)
+	if err != nil {
+		//nolint:wrapcheck // Caller drops objects that cannot be converted
+		return nil, err
+	}
+
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": resourceType.APIVersion(),
+		"kind":       resourceType.Kind,
+		"metadata":   content,
+	}}, nil
+}
+
+// recordingOLMReader records the Subscriptions and CSVs returned by the wrapped OLMReader.
+type recordingOLMReader struct {
+	recorder *Recorder
+	reader   client.OLMReader
+}
+
+func (o *recordingOLMReader) Available() bool {
+	return o.reader.Available()
+}
+
+func (o *recordingOLMReader) Subscriptions(namespace string) client.SubscriptionReader {
+	return &recordingSubscriptionReader{recorder: o.recorder, reader: o.reader.Subscriptions(namespace)}
+}
+
+func (o *recordingOLMReader) ClusterServiceVersions(namespace string) client.CSVReader {
+	return &recordingCSVReader{recorder: o.recorder, reader: o.reader.ClusterServiceVersions(namespace)}
+}
+
+type recordingSubscriptionReader struct {
+	recorder *Recorder
+	reader   client.SubscriptionReader
+}
+
+func (s *recordingSubscriptionReader) List(
+	ctx context.Context,
+	opts metav1.ListOptions,
+) (*operatorsv1alpha1.SubscriptionList, error) {
+	list, err := s.reader.List(ctx, opts)
+	if err != nil || list == nil {
+		//nolint:wrapcheck // Decorator passes through errors from the wrapped reader unchanged
+		return list, err
+	}
+
+	for i := range list.Items {
+		s.recorder.recordTyped(resources.Subscription, &list.Items[i])
+	}
+
+	return list, nil
+}
+
+func (s *recordingSubscriptionReader) Get(
+	ctx context.Context,
+	name string,
+	opts metav1.GetOptions,
+) (*operatorsv1alpha1.Subscription, error) {
+	sub, err := s.reader.Get(ctx, name, opts)
+	if err != nil || sub == nil {
+		//nolint:wrapcheck // Decorator passes through errors from the wrapped reader unchanged
+		return sub, err
+	}
+
+	s.recorder.recordTyped(resources.Subscription, sub)
+
+	return sub, nil
+}
+
+type recordingCSVReader struct {
+	recorder *Recorder
+	reader   client.CSVReader
+}
+
+func (c *recordingCSVReader) List(
+	ctx context.Context,
+	opts metav1.ListOptions,
+) (*operatorsv1alpha1.ClusterServiceVersionList, error) {
+	list, err := c.reader.List(ctx, opts)
+	if err != nil || list == nil {
+		//nolint:wrapcheck // Decorator passes through errors from the wrapped reader unchanged
+		return list, err
+	}
+
+	for i := range list.Items {
+		c.recorder.recordTyped(resources.ClusterServiceVersion, &list.Items[i])
+	}
+
+	return list, nil
+}
+
+func (c *recordingCSVReader) Get(
+	ctx context.Context,
+	name string,
+	opts metav1.GetOptions,
+) (*operatorsv1alpha1.ClusterServiceVersion, error) {
+	csv, err := c.reader.Get(ctx, name, opts)
+	if err != nil || csv == nil {
+		//nolint:wrapcheck // Decorator passes through errors from the wrapped reader unchanged
+		return csv, err
+	}
+
+	c.recorder.recordTyped(resources.ClusterServiceVersion, csv)
+
+	return csv, nil
+}
@@ -0,0 +1,160 @@
+package snapshot_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/snapshot"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+	"github.com/opendatahub-io/odh-cli/pkg/util/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+const secretYAML = `apiVersion: v1
+kind: Secret
+metadata:
+  name: creds
+  namespace: team-a
+data:
+  password: c2VjcmV0
+stringData:
+  token: plain
+`
+
+func TestRecorder_CapturesReadObjects(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	source, err := snapshot.NewReader(writeSnapshot(t, map[string]string{
+		"dsc.yaml":       dscYAML,
+		"dsci.yaml":      dsciYAML,
+		"notebooks.yaml": notebookListYAML,
+		"olm.yaml":       csvYAML,
+		"secret.yaml":    secretYAML,
+	}))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	recorder := snapshot.NewRecorder(source)
+
+	_, err = recorder.List(ctx, resources.DataScienceCluster)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = recorder.ListMetadata(ctx, resources.Notebook, client.WithNamespace("team-a"))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = recorder.GetResource(ctx, resources.Secret, "creds", client.InNamespace("team-a"))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = recorder.OLM().ClusterServiceVersions("").List(ctx, metav1.ListOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	objects := recorder.Objects()
+	paths := make([]string, 0, len(objects))
+
+	for _, obj := range objects {
+		paths = append(paths, obj.Path())
+	}
+
+	t.Run("records only objects that were read", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(paths).To(ConsistOf(
+			"cluster-scoped/datascienceclusters.datasciencecluster.opendatahub.io-default-dsc.yaml",
+			"team-a/notebooks.kubeflow.org-nb-a.yaml",
+			"team-a/secrets-creds.yaml",
+			"redhat-ods-operator/clusterserviceversions.operators.coreos.com-rhods-operator.2.25.1.yaml",
+		))
+	})
+
+	t.Run("redacts secret values", func(t *testing.T) {
+		g := NewWithT(t)
+
+		for _, obj := range objects {
+			if obj.Object.GetKind() != resources.Secret.Kind {
+				continue
+			}
+
+			password, err := jq.Query[string](obj.Object, ".data.password")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(password).To(BeEmpty())
+
+			_, err = jq.Query[map[string]any](obj.Object, ".stringData")
+			g.Expect(err).To(MatchError(jq.ErrNotFound))
+		}
+	})
+
+	t.Run("written snapshot replays through Reader", func(t *testing.T) {
+		for _, dest := range []string{"out", "out.tar.gz"} {
+			t.Run(dest, func(t *testing.T) {
+				g := NewWithT(t)
+
+				path := filepath.Join(t.TempDir(), dest)
+				g.Expect(snapshot.Write(path, objects)).To(Succeed())
+
+				replay, err := snapshot.NewReader(path)
+				g.Expect(err).ToNot(HaveOccurred())
+
+				notebooks, err := replay.List(ctx, resources.Notebook)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(notebooks).To(HaveLen(1))
+				g.Expect(notebooks[0].GetLabels()).To(HaveKeyWithValue("app", "jupyter"))
+
+				csvs, err := replay.OLM().ClusterServiceVersions("").List(ctx, metav1.ListOptions{
+					LabelSelector: "operators.coreos.com/rhods-operator.redhat-ods-operator",
+				})
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(csvs.Items).To(HaveLen(1))
+				g.Expect(csvs.Items[0].Spec.Version.String()).To(Equal("2.25.1"))
+			})
+		}
+	})
+
+	t.Run("archives are reproducible", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		first := filepath.Join(dir, "first.tar.gz")
+		second := filepath.Join(dir, "second.tar.gz")
+
+		g.Expect(snapshot.Write(first, objects)).To(Succeed())
+		g.Expect(snapshot.Write(second, recorder.Objects())).To(Succeed())
+
+		a, err := os.ReadFile(first)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		b, err := os.ReadFile(second)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(a).To(Equal(b))
+	})
+}
+
+func TestRecorder_FullReadReplacesMetadata(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	source, err := snapshot.NewReader(writeSnapshot(t, map[string]string{
+		"dspa.yaml": dspaYAML,
+	}))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	recorder := snapshot.NewRecorder(source)
+
+	_, err = recorder.ListMetadata(ctx, resources.DataSciencePipelinesApplicationV1Alpha1)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = recorder.List(ctx, resources.DataSciencePipelinesApplicationV1Alpha1)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = recorder.ListMetadata(ctx, resources.DataSciencePipelinesApplicationV1Alpha1)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	objects := recorder.Objects()
+	g.Expect(objects).To(HaveLen(1))
+	g.Expect(objects[0].Object.GetAPIVersion()).To(Equal(resources.DataSciencePipelinesApplicationV1Alpha1.APIVersion()))
+}
@@ -0,0 +1,156 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	dirPermissions  = 0o750
+	filePermissions = 0o600
+
+	clusterScopedDir = "cluster-scoped"
+)
+
+// Object is a captured object together with the resource it was read as.
+type Object struct {
+	Resource schema.GroupResource
+	Object   *unstructured.Unstructured
+}
+
+// Path returns the object's location inside a snapshot, matching the layout
+// written by backup.WriteResourceToFile: $namespace/$resource.$group-$name.yaml,
+// with cluster-scoped objects under "cluster-scoped".
+func (o Object) Path() string {
+	namespace := o.Object.GetNamespace()
+	if namespace == "" {
+		namespace = clusterScopedDir
+	}
+
+	resource := o.Resource.Resource
+	if o.Resource.Group != "" {
+		resource = o.Resource.Resource + "." + o.Resource.Group
+	}
+
+	return path.Join(namespace, fmt.Sprintf("%s-%s.yaml", resource, o.Object.GetName()))
+}
+
+// IsArchive reports whether path names a tar archive by its extension.
+func IsArchive(path string) bool {
+	return strings.HasSuffix(path, ".tar") || isGzipArchive(path)
+}
+
+func isGzipArchive(path string) bool {
+	return strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
+
+// Write stores objects at dest. A dest ending in .tar, .tar.gz or .tgz produces an archive,
+// anything else a directory. Output is reproducible: entries are sorted by path, and
+// archive headers carry no timestamps or ownership.
+func Write(dest string, objects []Object) error {
+	objects = slices.Clone(objects)
+	slices.SortFunc(objects, func(a, b Object) int {
+		return strings.Compare(a.Path(), b.Path())
+	})
+
+	if IsArchive(dest) {
+		return writeArchive(dest, objects)
+	}
+
+	return writeDir(dest, objects)
+}
+
+func writeDir(dir string, objects []Object) error {
+	for _, obj := range objects {
+		data, err := yaml.Marshal(obj.Object.Object)
+		if err != nil {
+			return fmt.Errorf("marshaling %s to YAML: %w", obj.Path(), err)
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(obj.Path()))
+		if err := os.MkdirAll(filepath.Dir(target), dirPermissions); err != nil {
+			return fmt.Errorf("creating directory: %w", err)
+		}
+
+		if err := os.WriteFile(target, data, filePermissions); err != nil {
+			return fmt.Errorf("writing file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func writeArchive(dest string, objects []Object) error {
+	if err := os.MkdirAll(filepath.Dir(dest), dirPermissions); err != nil {
+		return fmt.Errorf("creating directory: %w", err)
+	}
+
+	f, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, filePermissions)
+	if err != nil {
+		return fmt.Errorf("creating archive: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	var w io.Writer = f
+
+	var gz *gzip.Writer
+	if isGzipArchive(dest) {
+		gz = gzip.NewWriter(f)
+		w = gz
+	}
+
+	tw := tar.NewWriter(w)
+
+	for _, obj := range objects {
+		data, err := yaml.Marshal(obj.Object.Object)
+		if err != nil {
+			return fmt.Errorf("marshaling %s to YAML: %w", obj.Path(), err)
+		}
+
+		hdr := &tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     obj.Path(),
+			Mode:     filePermissions,
+			Size:     int64(len(data)),
+			ModTime:  time.Unix(0, 0),
+		}
+
+		if err := tw.WriteHeader(hdr); err != nil {
+			return fmt.Errorf("writing archive header: %w", err)
+		}
+
+		if _, err := tw.Write(data); err != nil {
+			return fmt.Errorf("writing archive entry: %w", err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("closing archive: %w", err)
+	}
+
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			return fmt.Errorf("closing gzip stream: %w", err)
+		}
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing archive: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package upgrade
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/blang/semver/v4"
+
+	"sigs.k8s.io/yaml"
+)
+
+// graphData is the upgrade graph shipped with the CLI.
+//
+//go:embed graph.yaml
+//nolint:gochecknoglobals // go:embed requires a package-level variable
+var graphData []byte
+
+// Release is an OpenShift AI release stream of the upgrade graph.
+type Release struct {
+	// Version is the major.minor version of the release, e.g. "2.25".
+	Version string `json:"version"`
+
+	// OpenShift is the minimum OpenShift version the release supports.
+	OpenShift string `json:"openshift"`
+
+	// UpgradesTo lists the releases an installation of this release can be upgraded to directly.
+	UpgradesTo []string `json:"upgradesTo,omitempty"`
+}
+
+// Hop is a single supported upgrade of a Plan.
+type Hop struct {
+	// From is the version the hop upgrades from.
+	From semver.Version
+
+	// To is the version the hop upgrades to.
+	To semver.Version
+
+	// MinOpenShiftVersion is the minimum OpenShift version required by To.
+	MinOpenShiftVersion semver.Version
+}
+
+// Graph holds the supported upgrade edges between releases.
+type Graph struct {
+	releases map[string]*release
+
+	// order lists the release keys as defined, oldest first
+	order []string
+}
+
+type release struct {
+	version    semver.Version
+	openShift  semver.Version
+	upgradesTo []string
+}
+
+// Parse reads an upgrade graph from YAML and validates that every edge leads to a newer
+// release of the graph.
+func Parse(data []byte) (*Graph, error) {
+	var doc struct {
+		Releases []Release `json:"releases"`
+	}
+
+	if err := yaml.UnmarshalStrict(data, &doc); err != nil {
+		return nil, fmt.Errorf("parsing upgrade graph: %w", err)
+	}
+
+	g := &Graph{releases: make(map[string]*release, len(doc.Releases))}
+
+	for _, r := range doc.Releases {
+		v, err := semver.ParseTolerant(r.Version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid release version %q: %w", r.Version, err)
+		}
+
+		key := streamKey(v)
+		if _, ok := g.releases[key]; ok {
+			return nil, fmt.Errorf("release %s is defined more than once", key)
+		}
+
+		ocp, err := semver.ParseTolerant(r.OpenShift)
+		if err != nil {
+			return nil, fmt.Errorf("invalid OpenShift version %q of release %s: %w", r.OpenShift, key, err)
+		}
+
+		g.releases[key] = &release{version: v, openShift: ocp}
+		g.order = append(g.order, key)
+	}
+
+	for i, r := range doc.Releases {
+		from := g.releases[g.order[i]]
+
+		for _, to := range r.UpgradesTo {
+			v, err := semver.ParseTolerant(to)
+			if err != nil {
+				return nil, fmt.Errorf("invalid upgrade %q of release %s: %w", to, r.Version, err)
+			}
+
+			next, ok := g.releases[streamKey(v)]
+			if !ok {
+				return nil, fmt.Errorf("release %s upgrades to unknown release %s", r.Version, to)
+			}
+
+			if !next.version.GT(from.version) {
+				return nil, fmt.Errorf("release %s upgrades to older release %s", r.Version, to)
+			}
+
+			from.upgradesTo = append(from.upgradesTo, streamKey(v))
+		}
+	}
+
+	return g, nil
+}
+
+// Default returns the upgrade graph shipped with the CLI. It panics if the graph is
+// invalid, since the graph is part of the binary.
+func Default() *Graph {
+	g, err := Parse(graphData)
+	if err != nil {
+		panic(fmt.Sprintf("loading embedded upgrade graph: %v", err))
+	}
+
+	return g
+}
+
+// Plan returns the shortest sequence of supported upgrades from the installed version to
+// the target version. Intermediate hops go to the .0 version of each release.
+func (g *Graph) Plan(from semver.Version, to semver.Version) ([]Hop, error) {
+	fromKey, toKey := streamKey(from), streamKey(to)
+
+	if _, ok := g.releases[fromKey]; !ok {
+		return nil, fmt.Errorf("current version %s is not a release of the upgrade graph", from)
+	}
+
+	if _, ok := g.releases[toKey]; !ok {
+		return nil, fmt.Errorf("target version %s is not a release of the upgrade graph", to)
+	}
+
+	if fromKey == toKey || to.LT(from) {
+		return nil, errors.New("target version must be a newer release than the current version")
+	}
+
+	// Breadth-first search finds a path with the fewest hops; edges are visited in
+	// definition order so that the plan is deterministic.
+	previous := map[string]string{fromKey: ""}
+	queue := []string{fromKey}
+
+	for len(queue) > 0 && previous[toKey] == "" {
+		key := queue[0]
+		queue = queue[1:]
+
+		for _, next := range g.releases[key].upgradesTo {
+			if _, seen := previous[next]; seen {
+				continue
+			}
+
+			previous[next] = key
+			queue = append(queue, next)
+		}
+	}
+
+	if _, ok := previous[toKey]; !ok {
+		return nil, fmt.Errorf("no supported upgrade path from %s to %s", fromKey, toKey)
+	}
+
+	var stops []string
+	for key := toKey; key != ""; key = previous[key] {
+		stops = append([]string{key}, stops...)
+	}
+
+	hops := make([]Hop, 0, len(stops)-1)
+	current := from
+
+	for i, key := range stops[1:] {
+		next := g.releases[key].version
+		if i == len(stops)-2 {
+			next = to
+		}
+
+		hops = append(hops, Hop{
+			From:                current,
+			To:                  next,
+			MinOpenShiftVersion: g.releases[key].openShift,
+		})
+
+		current = next
+	}
+
+	return hops, nil
+}
+
+// MinOpenShiftVersion returns the minimum OpenShift version of the release of v. For a
+// release the graph does not list, the minimum of the closest older release is returned.
+func (g *Graph) MinOpenShiftVersion(v semver.Version) (semver.Version, bool) {
+	if r, ok := g.releases[streamKey(v)]; ok {
+		return r.openShift, true
+	}
+
+	var closest *release
+
+	for _, key := range g.order {
+		r := g.releases[key]
+		if r.version.LT(v) && (closest == nil || r.version.GT(closest.version)) {
+			closest = r
+		}
+	}
+
+	if closest == nil {
+		return semver.Version{}, false
+	}
+
+	return closest.openShift, true
+}
+
+// streamKey returns the major.minor release of v.
+func streamKey(v semver.Version) string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
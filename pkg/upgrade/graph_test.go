@@ -0,0 +1,133 @@
+package upgrade_test
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/opendatahub-io/odh-cli/pkg/upgrade"
+
+	. "github.com/onsi/gomega"
+)
+
+const testGraph = `
+releases:
+  - version: "2.16"
+    openshift: "4.14.0"
+    upgradesTo: ["2.19", "2.22"]
+  - version: "2.19"
+    openshift: "4.15.0"
+    upgradesTo: ["2.22"]
+  - version: "2.22"
+    openshift: "4.16.0"
+    upgradesTo: ["3.0"]
+  - version: "3.0"
+    openshift: "4.19.9"
+  - version: "3.1"
+    openshift: "4.19.9"
+`
+
+func hopVersions(hops []upgrade.Hop) []string {
+	versions := make([]string, 0, len(hops))
+	for _, hop := range hops {
+		versions = append(versions, hop.From.String()+"->"+hop.To.String())
+	}
+
+	return versions
+}
+
+func TestGraph_Plan(t *testing.T) {
+	graph, err := upgrade.Parse([]byte(testGraph))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("should return the shortest path", func(t *testing.T) {
+		g := NewWithT(t)
+
+		hops, err := graph.Plan(semver.MustParse("2.16.2"), semver.MustParse("3.0.1"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(hopVersions(hops)).To(Equal([]string{"2.16.2->2.22.0", "2.22.0->3.0.1"}))
+		g.Expect(hops[1].MinOpenShiftVersion.String()).To(Equal("4.19.9"))
+	})
+
+	t.Run("should return a single hop for a direct upgrade", func(t *testing.T) {
+		g := NewWithT(t)
+
+		hops, err := graph.Plan(semver.MustParse("2.19.1"), semver.MustParse("2.22.0"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(hopVersions(hops)).To(Equal([]string{"2.19.1->2.22.0"}))
+		g.Expect(hops[0].MinOpenShiftVersion.String()).To(Equal("4.16.0"))
+	})
+
+	t.Run("should fail without a supported path", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := graph.Plan(semver.MustParse("3.0.0"), semver.MustParse("3.1.0"))
+		g.Expect(err).To(MatchError("no supported upgrade path from 3.0 to 3.1"))
+	})
+
+	t.Run("should fail for releases outside the graph", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := graph.Plan(semver.MustParse("2.8.0"), semver.MustParse("3.0.0"))
+		g.Expect(err).To(MatchError(ContainSubstring("current version 2.8.0 is not a release")))
+	})
+
+	t.Run("should fail when the target is not newer", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := graph.Plan(semver.MustParse("2.22.3"), semver.MustParse("2.22.0"))
+		g.Expect(err).To(MatchError(ContainSubstring("must be a newer release")))
+	})
+}
+
+func TestGraph_MinOpenShiftVersion(t *testing.T) {
+	g := NewWithT(t)
+
+	graph, err := upgrade.Parse([]byte(testGraph))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	v, ok := graph.MinOpenShiftVersion(semver.MustParse("2.19.3"))
+	g.Expect(ok).To(BeTrue())
+	g.Expect(v.String()).To(Equal("4.15.0"))
+
+	// Releases newer than the graph use the minimum of the latest release
+	v, ok = graph.MinOpenShiftVersion(semver.MustParse("3.5.0"))
+	g.Expect(ok).To(BeTrue())
+	g.Expect(v.String()).To(Equal("4.19.9"))
+
+	_, ok = graph.MinOpenShiftVersion(semver.MustParse("2.8.0"))
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		graph   string
+		wantErr string
+	}{
+		{"unknown field", "releases:\n  - version: \"2.16\"\n    ocp: \"4.14\"\n", "parsing upgrade graph"},
+		{"duplicate release", "releases:\n  - {version: \"2.16\", openshift: \"4.14\"}\n  - {version: \"2.16.1\", openshift: \"4.14\"}\n", "defined more than once"},
+		{"unknown edge", "releases:\n  - {version: \"2.16\", openshift: \"4.14\", upgradesTo: [\"2.19\"]}\n", "unknown release 2.19"},
+		{"downgrade edge", "releases:\n  - {version: \"2.16\", openshift: \"4.14\", upgradesTo: [\"2.8\"]}\n  - {version: \"2.8\", openshift: \"4.12\"}\n", "older release 2.8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := upgrade.Parse([]byte(tt.graph))
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestDefault(t *testing.T) {
+	g := NewWithT(t)
+
+	hops, err := upgrade.Default().Plan(semver.MustParse("2.16.0"), semver.MustParse("3.3.0"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(hops).ToNot(BeEmpty())
+	g.Expect(hops[len(hops)-1].To.String()).To(Equal("3.3.0"))
+}
@@ -0,0 +1,271 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/sync/singleflight"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util"
+)
+
+// Verify CachedReader implements Reader interface at compile time.
+var _ Reader = (*CachedReader)(nil)
+
+// CacheStats reports how many reads a CachedReader served from memory (hits)
+// and how many it forwarded to the wrapped reader (misses).
+type CacheStats struct {
+	Hits   int64
+	Misses int64
+}
+
+// CachedReader is a read-through Reader decorator that memoizes List, ListMetadata,
+// ListResources, Get, GetResource and GetResourceMetadata by resource, namespace,
+// name and selector.
+//
+// It has no expiry or invalidation and is meant to be scoped to a single command run,
+// where many checks read the same resources. Successful reads and NotFound errors are
+// cached; other errors are not, so transient failures are retried by the next caller.
+// Callers receive deep copies and may modify them freely. OLM reads are not cached.
+// CachedReader is safe for concurrent use; concurrent misses for the same key
+// result in a single call to the wrapped reader.
+type CachedReader struct {
+	reader Reader
+
+	mu      sync.RWMutex
+	entries map[string]cacheEntry
+	flight  singleflight.Group
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+type cacheEntry struct {
+	value any
+	err   error
+}
+
+// NewCachedReader wraps reader in a CachedReader with an empty cache.
+func NewCachedReader(reader Reader) *CachedReader {
+	return &CachedReader{
+		reader:  reader,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// Stats returns the hit and miss counts so far.
+func (c *CachedReader) Stats() CacheStats {
+	return CacheStats{
+		Hits:   c.hits.Load(),
+		Misses: c.misses.Load(),
+	}
+}
+
+func (c *CachedReader) List(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	opts ...ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	return cached(ctx, c, listKey("list", resourceType.GVR(), opts), deepCopyObjects, func() ([]*unstructured.Unstructured, error) {
+		return c.reader.List(ctx, resourceType, opts...)
+	})
+}
+
+func (c *CachedReader) ListMetadata(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	opts ...ListResourcesOption,
+) ([]*metav1.PartialObjectMetadata, error) {
+	return cached(ctx, c, listKey("listmeta", resourceType.GVR(), opts), deepCopyMetadataList, func() ([]*metav1.PartialObjectMetadata, error) {
+		return c.reader.ListMetadata(ctx, resourceType, opts...)
+	})
+}
+
+func (c *CachedReader) ListResources(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	opts ...ListResourcesOption,
+) ([]*unstructured.Unstructured, error) {
+	return cached(ctx, c, listKey("listresources", gvr, opts), deepCopyObjects, func() ([]*unstructured.Unstructured, error) {
+		return c.reader.ListResources(ctx, gvr, opts...)
+	})
+}
+
+func (c *CachedReader) Get(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	name string,
+	opts ...GetOption,
+) (*unstructured.Unstructured, error) {
+	return cached(ctx, c, getKey("get", gvr, name, opts), deepCopyObject, func() (*unstructured.Unstructured, error) {
+		return c.reader.Get(ctx, gvr, name, opts...)
+	})
+}
+
+func (c *CachedReader) GetResource(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	name string,
+	opts ...GetOption,
+) (*unstructured.Unstructured, error) {
+	return cached(ctx, c, getKey("getresource", resourceType.GVR(), name, opts), deepCopyObject, func() (*unstructured.Unstructured, error) {
+		return c.reader.GetResource(ctx, resourceType, name, opts...)
+	})
+}
+
+func (c *CachedReader) GetResourceMetadata(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	name string,
+	opts ...GetOption,
+) (*metav1.PartialObjectMetadata, error) {
+	return cached(ctx, c, getKey("getmeta", resourceType.GVR(), name, opts), deepCopyMetadata, func() (*metav1.PartialObjectMetadata, error) {
+		return c.reader.GetResourceMetadata(ctx, resourceType, name, opts...)
+	})
+}
+
+func (c *CachedReader) OLM() OLMReader {
+	return c.reader.OLM()
+}
+
+// cached serves key from the cache, or calls fetch once and stores the outcome.
+//
+// Concurrent callers share the fetch of the first one, which runs with that caller's
+// context. When the shared fetch failed because that context was cancelled or timed
+// out, callers whose own context is still live fetch again instead of failing with
+// another caller's context error.
+func cached[T any](
+	ctx context.Context,
+	c *CachedReader,
+	key string,
+	copyFn func(T) T,
+	fetch func() (T, error),
+) (T, error) {
+	for {
+		c.mu.RLock()
+		entry, ok := c.entries[key]
+		c.mu.RUnlock()
+
+		if ok {
+			c.hits.Add(1)
+
+			return cachedValue(entry, copyFn)
+		}
+
+		// Callers that joined another caller's in-flight fetch count as hits.
+		fetched := false
+
+		v, _, _ := c.flight.Do(key, func() (any, error) {
+			fetched = true
+			c.misses.Add(1)
+
+			value, err := fetch()
+			entry := cacheEntry{value: value, err: err}
+
+			if err == nil || IsResourceTypeNotFound(err) {
+				c.mu.Lock()
+				c.entries[key] = entry
+				c.mu.Unlock()
+			}
+
+			return entry, nil
+		})
+
+		//nolint:forcetypeassert // The flight function always returns a cacheEntry
+		entry = v.(cacheEntry)
+
+		if !fetched {
+			if isContextError(entry.err) && ctx.Err() == nil {
+				continue
+			}
+
+			c.hits.Add(1)
+		}
+
+		return cachedValue(entry, copyFn)
+	}
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+func cachedValue[T any](entry cacheEntry, copyFn func(T) T) (T, error) {
+	var zero T
+
+	if entry.err != nil {
+		//nolint:wrapcheck // Decorator passes through errors from the wrapped reader unchanged
+		return zero, entry.err
+	}
+
+	value, ok := entry.value.(T)
+	if !ok {
+		return zero, nil
+	}
+
+	return copyFn(value), nil
+}
+
+func listKey(op string, gvr schema.GroupVersionResource, opts []ListResourcesOption) string {
+	cfg := &ListResourcesConfig{}
+	util.ApplyOptions(cfg, opts...)
+
+	return fmt.Sprintf("%s|%s|%s|%s|%s|%d", op, gvr.String(), cfg.Namespace, cfg.LabelSelector, cfg.FieldSelector, cfg.Limit)
+}
+
+func getKey(op string, gvr schema.GroupVersionResource, name string, opts []GetOption) string {
+	cfg := &GetConfig{}
+	util.ApplyOptions(cfg, opts...)
+
+	return fmt.Sprintf("%s|%s|%s|%s", op, gvr.String(), cfg.Namespace, name)
+}
+
+func deepCopyObject(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	if obj == nil {
+		return nil
+	}
+
+	return obj.DeepCopy()
+}
+
+func deepCopyObjects(items []*unstructured.Unstructured) []*unstructured.Unstructured {
+	if items == nil {
+		return nil
+	}
+
+	result := make([]*unstructured.Unstructured, len(items))
+	for i, item := range items {
+		result[i] = deepCopyObject(item)
+	}
+
+	return result
+}
+
+func deepCopyMetadata(obj *metav1.PartialObjectMetadata) *metav1.PartialObjectMetadata {
+	if obj == nil {
+		return nil
+	}
+
+	return obj.DeepCopy()
+}
+
+func deepCopyMetadataList(items []*metav1.PartialObjectMetadata) []*metav1.PartialObjectMetadata {
+	if items == nil {
+		return nil
+	}
+
+	result := make([]*metav1.PartialObjectMetadata, len(items))
+	for i, item := range items {
+		result[i] = deepCopyMetadata(item)
+	}
+
+	return result
+}
@@ -0,0 +1,235 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func newNotebook(namespace string, name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resources.Notebook.APIVersion(),
+			"kind":       resources.Notebook.Kind,
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": namespace,
+				"labels":    map[string]any{"app": "jupyter"},
+			},
+		},
+	}
+}
+
+// newCountingClient returns a test client backed by a fake dynamic client, and a counter
+// of the API calls that reach it.
+func newCountingClient(objects ...runtime.Object) (client.Client, *dynamicfake.FakeDynamicClient, *atomic.Int64) {
+	listKinds := map[schema.GroupVersionResource]string{
+		resources.Notebook.GVR(): resources.Notebook.ListKind(),
+	}
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, objects...)
+
+	var calls atomic.Int64
+
+	dynamicClient.PrependReactor("*", "*", func(k8stesting.Action) (bool, runtime.Object, error) {
+		calls.Add(1)
+
+		return false, nil, nil
+	})
+
+	return client.NewForTesting(client.TestClientConfig{Dynamic: dynamicClient}), dynamicClient, &calls
+}
+
+func TestCachedReader_List(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	c, _, calls := newCountingClient(newNotebook("team-a", "nb-a"), newNotebook("team-b", "nb-b"))
+	reader := client.NewCachedReader(c)
+
+	all, err := reader.List(ctx, resources.Notebook)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(all).To(HaveLen(2))
+
+	again, err := reader.List(ctx, resources.Notebook)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(again).To(HaveLen(2))
+	g.Expect(calls.Load()).To(Equal(int64(1)))
+
+	t.Run("namespace and selector are part of the key", func(t *testing.T) {
+		g := NewWithT(t)
+
+		scoped, err := reader.List(ctx, resources.Notebook, client.WithNamespace("team-a"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(scoped).To(HaveLen(1))
+
+		_, err = reader.List(ctx, resources.Notebook, client.WithLabelSelector("app=jupyter"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(calls.Load()).To(Equal(int64(3)))
+	})
+
+	t.Run("callers get independent copies", func(t *testing.T) {
+		g := NewWithT(t)
+
+		first, err := reader.List(ctx, resources.Notebook)
+		g.Expect(err).ToNot(HaveOccurred())
+		first[0].SetName("mutated")
+
+		second, err := reader.List(ctx, resources.Notebook)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(second[0].GetName()).ToNot(Equal("mutated"))
+	})
+
+	t.Run("stats count hits and misses", func(t *testing.T) {
+		g := NewWithT(t)
+
+		stats := reader.Stats()
+		g.Expect(stats.Misses).To(Equal(calls.Load()))
+		g.Expect(stats.Hits).To(BeNumerically(">=", 3))
+	})
+}
+
+func TestCachedReader_Get(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	c, _, calls := newCountingClient(newNotebook("team-a", "nb-a"))
+	reader := client.NewCachedReader(c)
+
+	for range 3 {
+		nb, err := reader.GetResource(ctx, resources.Notebook, "nb-a", client.InNamespace("team-a"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(nb.GetName()).To(Equal("nb-a"))
+	}
+
+	for range 2 {
+		_, err := reader.GetResource(ctx, resources.Notebook, "missing", client.InNamespace("team-a"))
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	}
+
+	g.Expect(calls.Load()).To(Equal(int64(2)))
+	g.Expect(reader.Stats()).To(Equal(client.CacheStats{Hits: 3, Misses: 2}))
+}
+
+func TestCachedReader_DoesNotCacheTransientErrors(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	c, dynamicClient, _ := newCountingClient(newNotebook("team-a", "nb-a"))
+
+	var failed atomic.Bool
+
+	dynamicClient.PrependReactor("list", "*", func(k8stesting.Action) (bool, runtime.Object, error) {
+		if failed.CompareAndSwap(false, true) {
+			return true, nil, errors.New("connection refused")
+		}
+
+		return false, nil, nil
+	})
+
+	reader := client.NewCachedReader(c)
+
+	_, err := reader.List(ctx, resources.Notebook)
+	g.Expect(err).To(HaveOccurred())
+
+	items, err := reader.List(ctx, resources.Notebook)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(items).To(HaveLen(1))
+	g.Expect(reader.Stats().Misses).To(Equal(int64(2)))
+}
+
+func TestCachedReader_ConcurrentReads(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	c, _, calls := newCountingClient(newNotebook("team-a", "nb-a"))
+	reader := client.NewCachedReader(c)
+
+	var wg sync.WaitGroup
+
+	for range 16 {
+		wg.Go(func() {
+			items, err := reader.List(ctx, resources.Notebook)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(items).To(HaveLen(1))
+		})
+	}
+
+	wg.Wait()
+
+	g.Expect(calls.Load()).To(BeNumerically("<=", 16))
+	g.Expect(reader.Stats().Hits + reader.Stats().Misses).To(Equal(int64(16)))
+}
+
+func TestCachedReader_RetriesAfterAnotherCallersContextError(t *testing.T) {
+	g := NewWithT(t)
+
+	c, dynamicClient, _ := newCountingClient(newNotebook("team-a", "nb-a"))
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	var first atomic.Bool
+
+	dynamicClient.PrependReactor("list", "*", func(k8stesting.Action) (bool, runtime.Object, error) {
+		if first.CompareAndSwap(false, true) {
+			close(started)
+			<-release
+
+			return true, nil, context.DeadlineExceeded
+		}
+
+		return false, nil, nil
+	})
+
+	reader := client.NewCachedReader(c)
+
+	leaderCtx, cancel := context.WithCancel(t.Context())
+	leaderErr := make(chan error, 1)
+
+	go func() {
+		_, err := reader.List(leaderCtx, resources.Notebook)
+		leaderErr <- err
+	}()
+
+	<-started
+
+	type result struct {
+		items []*unstructured.Unstructured
+		err   error
+	}
+
+	waiter := make(chan result, 1)
+
+	go func() {
+		items, err := reader.List(t.Context(), resources.Notebook)
+		waiter <- result{items, err}
+	}()
+
+	// Let the waiter join the in-flight fetch before the leader's context expires.
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	close(release)
+
+	g.Expect(<-leaderErr).To(MatchError(context.DeadlineExceeded))
+
+	res := <-waiter
+	g.Expect(res.err).ToNot(HaveOccurred())
+	g.Expect(res.items).To(HaveLen(1))
+	g.Expect(reader.Stats().Misses).To(Equal(int64(2)))
+}
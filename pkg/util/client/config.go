@@ -5,6 +5,7 @@ import (
 
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
 
 const (
@@ -17,6 +18,10 @@ const (
 	// This is significantly higher than kubectl's default (10) to handle
 	// initial spikes when all workers start simultaneously.
 	DefaultBurst = 100
+
+	// kubeconfigName names the cluster, user and context of a kubeconfig written by
+	// NewKubeconfig.
+	kubeconfigName = "odh-cli"
 )
 
 // ConfigureThrottling configures QPS and Burst on a REST config.
@@ -52,3 +57,50 @@ func NewRESTConfig(
 
 	return restConfig, nil
 }
+
+// NewKubeconfig returns a kubeconfig with a single context that connects like
+// restConfig, so that external processes reach the cluster with the server, credentials,
+// TLS and impersonation settings resolved from the command line. The context defaults
+// to namespace.
+func NewKubeconfig(restConfig *rest.Config, namespace string) *clientcmdapi.Config {
+	cluster := clientcmdapi.NewCluster()
+	cluster.Server = restConfig.Host
+	cluster.TLSServerName = restConfig.ServerName
+	cluster.InsecureSkipTLSVerify = restConfig.Insecure
+	cluster.CertificateAuthority = restConfig.CAFile
+	cluster.CertificateAuthorityData = restConfig.CAData
+
+	authInfo := clientcmdapi.NewAuthInfo()
+	authInfo.ClientCertificate = restConfig.CertFile
+	authInfo.ClientCertificateData = restConfig.CertData
+	authInfo.ClientKey = restConfig.KeyFile
+	authInfo.ClientKeyData = restConfig.KeyData
+	authInfo.Username = restConfig.Username
+	authInfo.Password = restConfig.Password
+	authInfo.Impersonate = restConfig.Impersonate.UserName
+	authInfo.ImpersonateUID = restConfig.Impersonate.UID
+	authInfo.ImpersonateGroups = restConfig.Impersonate.Groups
+	authInfo.ImpersonateUserExtra = restConfig.Impersonate.Extra
+	authInfo.AuthProvider = restConfig.AuthProvider
+	authInfo.Exec = restConfig.ExecProvider
+
+	// A token file is referenced rather than copied, so that rotated tokens are picked up.
+	if restConfig.BearerTokenFile != "" {
+		authInfo.TokenFile = restConfig.BearerTokenFile
+	} else {
+		authInfo.Token = restConfig.BearerToken
+	}
+
+	kubeContext := clientcmdapi.NewContext()
+	kubeContext.Cluster = kubeconfigName
+	kubeContext.AuthInfo = kubeconfigName
+	kubeContext.Namespace = namespace
+
+	config := clientcmdapi.NewConfig()
+	config.Clusters[kubeconfigName] = cluster
+	config.AuthInfos[kubeconfigName] = authInfo
+	config.Contexts[kubeconfigName] = kubeContext
+	config.CurrentContext = kubeconfigName
+
+	return config
+}
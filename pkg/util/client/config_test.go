@@ -5,6 +5,7 @@ import (
 
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/opendatahub-io/odh-cli/pkg/util/client"
 
@@ -86,3 +87,44 @@ func TestDefaultConstants(t *testing.T) {
 		g.Expect(client.DefaultBurst).To(Equal(100))
 	})
 }
+
+func TestNewKubeconfig(t *testing.T) {
+	g := NewWithT(t)
+
+	restConfig := &rest.Config{
+		Host:        "https://api.example.com:6443",
+		BearerToken: "s3cr3t",
+		Impersonate: rest.ImpersonationConfig{UserName: "admin", Groups: []string{"ops"}},
+		TLSClientConfig: rest.TLSClientConfig{
+			ServerName: "api.internal",
+			CAData:     []byte("ca"),
+		},
+	}
+
+	kubeconfig := client.NewKubeconfig(restConfig, "team-a")
+
+	// Loading the kubeconfig yields the same connection.
+	loaded, err := clientcmd.NewDefaultClientConfig(*kubeconfig, nil).ClientConfig()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(loaded.Host).To(Equal(restConfig.Host))
+	g.Expect(loaded.BearerToken).To(Equal(restConfig.BearerToken))
+	g.Expect(loaded.Impersonate.UserName).To(Equal("admin"))
+	g.Expect(loaded.Impersonate.Groups).To(Equal([]string{"ops"}))
+	g.Expect(loaded.ServerName).To(Equal("api.internal"))
+	g.Expect(loaded.CAData).To(Equal([]byte("ca")))
+
+	namespace, _, err := clientcmd.NewDefaultClientConfig(*kubeconfig, nil).Namespace()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(namespace).To(Equal("team-a"))
+
+	t.Run("should reference token files", func(t *testing.T) {
+		g := NewWithT(t)
+
+		withFile := rest.CopyConfig(restConfig)
+		withFile.BearerTokenFile = "/var/run/secrets/token"
+
+		authInfo := client.NewKubeconfig(withFile, "").AuthInfos["odh-cli"]
+		g.Expect(authInfo.TokenFile).To(Equal("/var/run/secrets/token"))
+		g.Expect(authInfo.Token).To(BeEmpty())
+	})
+}
@@ -72,8 +72,16 @@ type Reader interface {
 }
 
 // Writer provides write access to Kubernetes resources.
-// Currently empty -- write operations will be added as needed.
-type Writer any
+// Write operations are added as needed; most writes still go through the clientsets.
+type Writer interface {
+	// CreateOrUpdate creates the object, or replaces the existing object of the same
+	// name. Writing the same object again leaves one object with the latest content.
+	CreateOrUpdate(
+		ctx context.Context,
+		resourceType resources.ResourceType,
+		obj *unstructured.Unstructured,
+	) (*unstructured.Unstructured, error)
+}
 
 // Client provides full access to Kubernetes resources.
 // Embeds Reader and Writer, and exposes the underlying clientsets
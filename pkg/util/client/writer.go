@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+)
+
+// FieldManager identifies the CLI as the writer of objects it creates or updates.
+const FieldManager = "odh-cli"
+
+// CreateOrUpdate creates obj, or updates the existing object of the same name and namespace.
+// The resourceVersion of the existing object is used for the update, so a concurrent
+// change made since it was read fails with a conflict rather than being overwritten.
+func (c *defaultClient) CreateOrUpdate(
+	ctx context.Context,
+	resourceType resources.ResourceType,
+	obj *unstructured.Unstructured,
+) (*unstructured.Unstructured, error) {
+	ri := c.dynamic.Resource(resourceType.GVR()).Namespace(obj.GetNamespace())
+	ref := resourceType.Kind + " " + obj.GetName()
+
+	existing, err := ri.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		created, err := ri.Create(ctx, obj, metav1.CreateOptions{FieldManager: FieldManager})
+		if err != nil {
+			return nil, fmt.Errorf("creating %s: %w", ref, err)
+		}
+
+		return created, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("getting %s: %w", ref, err)
+	}
+
+	obj = obj.DeepCopy()
+	obj.SetResourceVersion(existing.GetResourceVersion())
+
+	updated, err := ri.Update(ctx, obj, metav1.UpdateOptions{FieldManager: FieldManager})
+	if err != nil {
+		return nil, fmt.Errorf("updating %s: %w", ref, err)
+	}
+
+	return updated, nil
+}
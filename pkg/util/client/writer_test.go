@@ -0,0 +1,63 @@
+//nolint:testpackage // Tests internal implementation (Client fields)
+package client
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func newTestConfigMap(value string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]any{
+				"name":      "report",
+				"namespace": testNamespace,
+			},
+			"data": map[string]any{
+				"key": value,
+			},
+		},
+	}
+}
+
+func TestCreateOrUpdate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	scheme := runtime.NewScheme()
+	_ = metav1.AddMetaToScheme(scheme)
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, map[schema.GroupVersionResource]string{
+		resources.ConfigMap.GVR(): "ConfigMapList",
+	})
+	client := &defaultClient{
+		dynamic:   dynamicClient,
+		olmReader: newOLMReader(nil),
+	}
+
+	// The first call creates the object.
+	_, err := client.CreateOrUpdate(ctx, resources.ConfigMap, newTestConfigMap("first"))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// Writing again updates it in place.
+	_, err = client.CreateOrUpdate(ctx, resources.ConfigMap, newTestConfigMap("second"))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	list, err := dynamicClient.Resource(resources.ConfigMap.GVR()).Namespace(testNamespace).List(ctx, metav1.ListOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(list.Items).To(HaveLen(1))
+
+	value, _, _ := unstructured.NestedString(list.Items[0].Object, "data", "key")
+	g.Expect(value).To(Equal("second"))
+}
@@ -123,6 +123,16 @@ func Query[T any](value any, jqQuery string) (T, error) {
 	return convertedResult, nil
 }
 
+// Validate reports whether expression is a syntactically valid JQ expression, so that
+// expressions read from configuration can be rejected before they are evaluated.
+func Validate(expression string) error {
+	if _, err := gojq.Parse(expression); err != nil {
+		return fmt.Errorf("failed to parse jq query: %w", err)
+	}
+
+	return nil
+}
+
 // Predicate returns a filter function that evaluates a JQ boolean expression against an
 // unstructured object. Returns true when the expression evaluates to true, false otherwise.
 // Field-not-found and type mismatch errors are treated as false (no match), not as errors.
@@ -459,6 +459,13 @@ func TestPredicate(t *testing.T) {
 	})
 }
 
+func TestValidate(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(jq.Validate(`(.status.conditions // []) | any(.type == "Ready")`)).To(Succeed())
+	g.Expect(jq.Validate(".spec.[")).To(MatchError(ContainSubstring("failed to parse jq query")))
+}
+
 // TestQuery_NestedStructConversion tests deep nested structure conversion.
 func TestQuery_NestedStructConversion(t *testing.T) {
 	g := NewWithT(t)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -105,3 +106,34 @@ func isOpenShiftAIGroup(group string) bool {
 
 	return false
 }
+
+// ResolveResource maps a resource argument to the preferred served version of the
+// resource using mapper. arg may name a resource (notebooks), a resource in a group
+// (notebooks.kubeflow.org), a resource at a version (inferenceservices.v1beta1.serving.kserve.io),
+// or a Kind in any of the same forms (Notebook, Notebook.kubeflow.org).
+func ResolveResource(mapper meta.RESTMapper, arg string) (schema.GroupVersionResource, error) {
+	fullySpecified, groupResource := schema.ParseResourceArg(arg)
+	if fullySpecified != nil {
+		if gvr, err := mapper.ResourceFor(*fullySpecified); err == nil {
+			return gvr, nil
+		}
+	}
+
+	gvr, err := mapper.ResourceFor(groupResource.WithVersion(""))
+	if err == nil {
+		return gvr, nil
+	}
+
+	fullySpecifiedKind, groupKind := schema.ParseKindArg(arg)
+	if fullySpecifiedKind != nil {
+		if mapping, kindErr := mapper.RESTMapping(fullySpecifiedKind.GroupKind(), fullySpecifiedKind.Version); kindErr == nil {
+			return mapping.Resource, nil
+		}
+	}
+
+	if mapping, kindErr := mapper.RESTMapping(groupKind); kindErr == nil {
+		return mapping.Resource, nil
+	}
+
+	return schema.GroupVersionResource{}, fmt.Errorf("resolving resource %q: %w", arg, err)
+}
@@ -0,0 +1,61 @@
+package discovery_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/odh-cli/pkg/resources"
+	"github.com/opendatahub-io/odh-cli/pkg/util/kube/discovery"
+
+	. "github.com/onsi/gomega"
+)
+
+func newMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+
+	for _, rt := range []resources.ResourceType{
+		resources.Notebook,
+		resources.InferenceService,
+		resources.Secret,
+	} {
+		mapper.Add(rt.GVK(), meta.RESTScopeNamespace)
+	}
+
+	return mapper
+}
+
+func TestResolveResource(t *testing.T) {
+	mapper := newMapper()
+
+	for _, tc := range []struct {
+		name     string
+		arg      string
+		expected schema.GroupVersionResource
+	}{
+		{name: "resource", arg: "notebooks", expected: resources.Notebook.GVR()},
+		{name: "resource.group", arg: "notebooks.kubeflow.org", expected: resources.Notebook.GVR()},
+		{name: "resource.version.group", arg: "inferenceservices.v1beta1.serving.kserve.io", expected: resources.InferenceService.GVR()},
+		{name: "core resource", arg: "secrets", expected: resources.Secret.GVR()},
+		{name: "Kind", arg: "Notebook", expected: resources.Notebook.GVR()},
+		{name: "Kind.group", arg: "Notebook.kubeflow.org", expected: resources.Notebook.GVR()},
+		{name: "Kind.version.group", arg: "InferenceService.v1beta1.serving.kserve.io", expected: resources.InferenceService.GVR()},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			gvr, err := discovery.ResolveResource(mapper, tc.arg)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(gvr).To(Equal(tc.expected))
+		})
+	}
+
+	t.Run("should wrap the no match error of unserved resources", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := discovery.ResolveResource(mapper, "rayclusters.ray.io")
+		g.Expect(err).To(MatchError(ContainSubstring(`resolving resource "rayclusters.ray.io"`)))
+		g.Expect(meta.IsNoMatchError(err)).To(BeTrue())
+	})
+}